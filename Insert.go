@@ -19,7 +19,7 @@ import (
 // INSERT INTO Customers (CustomerName, City, Country)
 // SELECT SupplierName, City, Country FROM Suppliers WHERE Country='Germany';
 type Insert struct {
-	Table   string   // Table specifies the name of the table into which the data will be inserted.
+	Table   any      // Table specifies the name of the table into which the data will be inserted, a string or an Ident.
 	Columns []string // Columns defines the list of column names for the INSERT statement.
 }
 
@@ -27,7 +27,12 @@ type Insert struct {
 // It joins the Columns slice with commas and formats it into the SQL syntax.
 // Returns: A string representation of the SQL INSERT statement.
 func (i *Insert) String() string {
+	return i.render(nil)
+}
+
+// render generates the SQL of String with the dialect d, the default dialect when nil.
+func (i *Insert) render(d Dialect) string {
 	columnsStr := strings.Join(i.Columns, ", ") // Joins the column names with commas.
 
-	return fmt.Sprintf("INSERT INTO %s (%s)", i.Table, columnsStr) // Formats the final SQL string.
+	return fmt.Sprintf("INSERT INTO %s (%s)", dialectField(d, i.Table), columnsStr) // Formats the final SQL string.
 }
@@ -0,0 +1,317 @@
+package fluentsql
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ConflictAction defines what an upsert does when the inserted row conflicts with an existing one.
+type ConflictAction int
+
+const (
+	ConflictNone      ConflictAction = iota // No upsert clause
+	ConflictDoNothing                       // Skip the conflicting row (DO NOTHING)
+	ConflictDoUpdate                        // Update the existing row (DO UPDATE SET / ON DUPLICATE KEY UPDATE)
+)
+
+// ExcludedField refers to the value a column would have had in the row proposed for insertion.
+// It is used as a value of the upsert assignments, or as an operand of their expressions.
+//
+// Rendering:
+//   - PostgreSQL, SQLite: EXCLUDED.col
+//   - Oracle: EXCLUDED.col, the source rows of the MERGE statement are aliased EXCLUDED
+//   - MySQL: VALUES(col), or row_alias.col when a row alias is set with InsertBuilder.RowAlias
+type ExcludedField string
+
+// Excluded creates an ExcludedField for the given column.
+//
+// Parameters:
+//   - column: The name of the inserted column.
+//
+// Returns:
+//   - ExcludedField: The reference to the proposed value of the column.
+//
+// Examples:
+//
+//	InsertInstance().Insert("users", "email", "name").Row("a@b.c", "Ann").OnConflict("email").DoUpdate("name", Excluded("name"))
+func Excluded(column string) ExcludedField {
+	return ExcludedField(column)
+}
+
+// Value returns the SQL reference to the proposed value of the column for the current dialect.
+//
+// Returns:
+//   - string: "EXCLUDED.col" or "VALUES(col)" on MySQL.
+func (e ExcludedField) Value() string {
+	return e.excluded(nil, "")
+}
+
+// excluded returns the SQL reference to the proposed value of the column.
+//
+// Parameters:
+//   - d: The dialect of the builder, the default dialect when nil.
+//   - alias: The MySQL row alias, if any.
+//
+// Returns:
+//   - string: The SQL reference.
+func (e ExcludedField) excluded(d Dialect, alias string) string {
+	if isDialect(d, MySQL) {
+		if alias != "" {
+			return alias + "." + string(e)
+		}
+
+		return fmt.Sprintf("VALUES(%s)", string(e))
+	}
+
+	return "EXCLUDED." + string(e)
+}
+
+// OnConflict clause represents the upsert part of an INSERT statement.
+//
+// Syntax:
+//
+//	PostgreSQL, SQLite:
+//	  ON CONFLICT [(col [, col] ...) | ON CONSTRAINT constraint_name]
+//	    { DO NOTHING | DO UPDATE SET assignment_list [WHERE condition] }
+//
+//	MySQL:
+//	  [AS row_alias] ON DUPLICATE KEY UPDATE assignment_list
+//
+//	Oracle, as the WHEN MATCHED branch of the MERGE statement generated by InsertBuilder:
+//	  WHEN MATCHED THEN UPDATE SET assignment_list [WHERE condition]
+type OnConflict struct {
+	Columns    []string       // Columns defines the conflict target columns.
+	Constraint string         // Constraint defines the conflict target constraint (PostgreSQL).
+	Action     ConflictAction // Action specifies DO NOTHING or DO UPDATE.
+	Set        UpdateSet      // Set holds the assignments of DO UPDATE.
+	Where      Where          // Where restricts the rows updated by DO UPDATE (PostgreSQL, SQLite).
+	Alias      string         // Alias is the MySQL row alias used to refer to the inserted values.
+}
+
+// resolve replaces the ExcludedField values of an assignment with their SQL reference.
+//
+// Parameters:
+//   - d: The dialect of the builder, the default dialect when nil.
+//   - item: The assignment.
+//
+// Returns:
+//   - UpdateItem: The assignment ready to be rendered.
+func (c *OnConflict) resolve(d Dialect, item UpdateItem) UpdateItem {
+	item.Value = c.resolveValue(d, item.Value)
+
+	return item
+}
+
+// resolveValue replaces the ExcludedField operands of a value with their SQL reference, in copies of the
+// expressions holding them, e.g. Add(Ident("n"), Excluded("n")) is written "n" + VALUES(n) on MySQL.
+//
+// Parameters:
+//   - d: The dialect of the builder, the default dialect when nil.
+//   - value: The value of an assignment, or an operand of its expression.
+//
+// Returns:
+//   - any: The value ready to be rendered.
+func (c *OnConflict) resolveValue(d Dialect, value any) any {
+	switch v := value.(type) {
+	case ExcludedField:
+		return ValueField(v.excluded(d, c.Alias))
+	case *FuncExpr:
+		args := make([]any, len(v.Args))
+		for i, arg := range v.Args {
+			args[i] = c.resolveValue(d, arg)
+		}
+
+		return &FuncExpr{Name: v.Name, Args: args}
+	case *BinaryExpr:
+		return &BinaryExpr{Left: c.resolveValue(d, v.Left), Op: v.Op, Right: c.resolveValue(d, v.Right)}
+	case *CastExpr:
+		return &CastExpr{Value: c.resolveValue(d, v.Value), Type: v.Type}
+	case *AliasExpr:
+		return &AliasExpr{Value: c.resolveValue(d, v.Value), Alias: v.Alias}
+	case *RawExpr:
+		args := make([]any, len(v.Args))
+		for i, arg := range v.Args {
+			args[i] = c.resolveValue(d, arg)
+		}
+
+		return &RawExpr{SQL: v.SQL, Args: args}
+	case *Aggregate:
+		resolved := *v
+		resolved.Field = c.resolveValue(d, v.Field)
+		resolved.Conditions = c.resolveConditions(d, v.Conditions)
+
+		return &resolved
+	case *Case:
+		resolved := *v
+		resolved.Exp = c.resolveValue(d, v.Exp)
+		resolved.ElseValue = c.resolveValue(d, v.ElseValue)
+		resolved.WhenClauses = make([]WhenCase, len(v.WhenClauses))
+
+		for i, whenClause := range v.WhenClauses {
+			if conditions, ok := whenClause.conditions(); ok {
+				whenClause.Conditions = c.resolveConditions(d, conditions)
+			} else {
+				whenClause.Conditions = c.resolveValue(d, whenClause.Conditions)
+			}
+
+			whenClause.Value = c.resolveValue(d, whenClause.Value)
+			resolved.WhenClauses[i] = whenClause
+		}
+
+		return &resolved
+	}
+
+	return value
+}
+
+// resolveConditions replaces the ExcludedField operands of conditions with their SQL reference,
+// in copies of the conditions and of their groups.
+//
+// Parameters:
+//   - d: The dialect of the builder, the default dialect when nil.
+//   - conditions: The conditions, e.g. of the WHERE clause of DO UPDATE.
+//
+// Returns:
+//   - []Condition: The conditions ready to be rendered.
+func (c *OnConflict) resolveConditions(d Dialect, conditions []Condition) []Condition {
+	if conditions == nil {
+		return nil
+	}
+
+	resolved := make([]Condition, len(conditions))
+
+	for i, condition := range conditions {
+		condition.Field = c.resolveValue(d, condition.Field)
+		condition.Value = c.resolveValue(d, condition.Value)
+		condition.Group = c.resolveConditions(d, condition.Group)
+		resolved[i] = condition
+	}
+
+	return resolved
+}
+
+// build combines the rendered assignments and WHERE clause into the upsert clause for the dialect.
+//
+// Parameters:
+//   - d: The dialect of the builder, the default dialect when nil.
+//   - assignments: The rendered assignments of DO UPDATE.
+//   - where: The rendered WHERE clause of DO UPDATE.
+//
+// Returns:
+//   - string: The upsert clause. Returns an empty string if no upsert is set.
+//   - error: ErrNotSupported when the dialect cannot express the upsert.
+func (c *OnConflict) build(d Dialect, assignments []string, where string) (string, error) {
+	if c.Action == ConflictNone {
+		return "", nil
+	}
+
+	// SQL Server has no upsert clause, it requires a MERGE statement.
+	// ClickHouse deduplicates rows with table engines such as ReplacingMergeTree instead.
+	if isDialect(d, SQLServer) || isDialect(d, ClickHouse) {
+		return "", fmt.Errorf("%w: %s ON CONFLICT", ErrNotSupported, dialectOr(d).Name())
+	}
+
+	// Oracle upserts with a MERGE statement, the clause is its WHEN MATCHED branch.
+	if isDialect(d, Oracle) {
+		if c.Constraint != "" || len(c.Columns) == 0 {
+			return "", fmt.Errorf("%w: %s MERGE without conflict columns", ErrNotSupported, Oracle)
+		}
+
+		if c.Alias != "" {
+			return "", fmt.Errorf("%w: %s row alias", ErrNotSupported, Oracle)
+		}
+
+		if c.Action == ConflictDoNothing {
+			return "", nil
+		}
+
+		parts := []string{fmt.Sprintf("WHEN MATCHED THEN UPDATE SET %s", strings.Join(assignments, ", "))}
+
+		if where != "" {
+			parts = append(parts, where)
+		}
+
+		return strings.Join(parts, " "), nil
+	}
+
+	if isDialect(d, MySQL) {
+		var parts []string
+
+		if c.Alias != "" {
+			parts = append(parts, "AS "+c.Alias)
+		}
+
+		if where != "" {
+			return "", fmt.Errorf("%w: %s ON DUPLICATE KEY UPDATE ... WHERE", ErrNotSupported, MySQL)
+		}
+
+		// MySQL has no DO NOTHING, a no-op assignment of a key column keeps the existing row.
+		if c.Action == ConflictDoNothing {
+			if len(c.Columns) == 0 {
+				return "", fmt.Errorf("%w: %s DO NOTHING without conflict columns", ErrNotSupported, MySQL)
+			}
+
+			assignments = []string{fmt.Sprintf("%s = %s", c.Columns[0], c.Columns[0])}
+		}
+
+		parts = append(parts, fmt.Sprintf("ON DUPLICATE KEY UPDATE %s", strings.Join(assignments, ", ")))
+
+		return strings.Join(parts, " "), nil
+	}
+
+	if c.Alias != "" {
+		return "", fmt.Errorf("%w: %s row alias", ErrNotSupported, dialectOr(d).Name())
+	}
+
+	parts := []string{"ON CONFLICT"}
+
+	if c.Constraint != "" {
+		if isDialect(d, SQLite) {
+			return "", fmt.Errorf("%w: %s ON CONFLICT ON CONSTRAINT", ErrNotSupported, SQLite)
+		}
+
+		parts = append(parts, "ON CONSTRAINT "+c.Constraint)
+	} else if len(c.Columns) > 0 {
+		parts = append(parts, fmt.Sprintf("(%s)", strings.Join(c.Columns, ", ")))
+	} else if c.Action == ConflictDoUpdate {
+		// DO UPDATE requires a conflict target, only DO NOTHING applies to any conflict.
+		return "", fmt.Errorf("%w: %s ON CONFLICT DO UPDATE without conflict target", ErrNotSupported, dialectOr(d).Name())
+	}
+
+	if c.Action == ConflictDoNothing {
+		parts = append(parts, "DO NOTHING")
+	} else {
+		parts = append(parts, fmt.Sprintf("DO UPDATE SET %s", strings.Join(assignments, ", ")))
+
+		if where != "" {
+			parts = append(parts, where)
+		}
+	}
+
+	return strings.Join(parts, " "), nil
+}
+
+// String generates the upsert clause for the current dialect.
+// Upserts the dialect cannot express are omitted; use StringArgs to get an error instead.
+//
+// Returns:
+//   - string: E.g. "ON CONFLICT (email) DO UPDATE SET name = EXCLUDED.name".
+func (c *OnConflict) String() string {
+	return c.render(nil)
+}
+
+// render generates the SQL of String with the dialect d, the default dialect when nil.
+func (c *OnConflict) render(d Dialect) string {
+	var assignments []string
+
+	for _, item := range c.Set.Items {
+		item = c.resolve(d, item)
+		assignments = append(assignments, item.render(d))
+	}
+
+	where := Where{Conditions: c.resolveConditions(d, c.Where.Conditions)}
+
+	sql, _ := c.build(d, assignments, where.render(d))
+
+	return sql
+}
@@ -0,0 +1,140 @@
+package fluentsql
+
+import (
+	"fmt"
+	"strings"
+)
+
+// mergeSource is the alias of the rows proposed for insertion in a MERGE statement.
+// It matches the EXCLUDED pseudo table of PostgreSQL, so Excluded(col) refers to them on every dialect.
+const mergeSource = "excluded"
+
+// mergeRow generates a row of the MERGE source, selected from DUAL with the names of the inserted columns.
+//
+// Parameters:
+//   - values []string: The SQL of the row's values.
+//
+// Returns:
+//   - string: E.g. "SELECT :1 AS email, :2 AS name FROM dual".
+func (ib *InsertBuilder) mergeRow(values []string) string {
+	columns := make([]string, 0, len(values))
+
+	for i, value := range values {
+		if i < len(ib.insertStatement.Columns) {
+			value = fmt.Sprintf("%s AS %s", value, ib.insertStatement.Columns[i])
+		}
+
+		columns = append(columns, value)
+	}
+
+	return fmt.Sprintf("SELECT %s FROM dual", strings.Join(columns, ", "))
+}
+
+// merge combines the rendered source and WHEN MATCHED branch into the MERGE statement Oracle uses
+// instead of an upsert clause.
+//
+// Syntax:
+//
+//	MERGE INTO table USING (source) excluded ON (table.col = excluded.col [AND ...])
+//	  [WHEN MATCHED THEN UPDATE SET assignment_list [WHERE condition]]
+//	  WHEN NOT MATCHED THEN INSERT (col [, col] ...) VALUES (excluded.col [, excluded.col] ...)
+//
+// Parameters:
+//   - d: The dialect of the builder.
+//   - source string: The rows proposed for insertion, selected from DUAL or by the INSERT query.
+//   - matched string: The WHEN MATCHED branch, empty for DO NOTHING.
+//
+// Returns:
+//   - string: The MERGE statement.
+func (ib *InsertBuilder) merge(d Dialect, source, matched string) string {
+	table := dialectField(d, ib.insertStatement.Table)
+
+	var on []string
+	for _, column := range ib.conflictStatement.Columns {
+		on = append(on, fmt.Sprintf("%s.%s = %s.%s", table, column, mergeSource, column))
+	}
+
+	var values []string
+	for _, column := range ib.insertStatement.Columns {
+		values = append(values, mergeSource+"."+column)
+	}
+
+	parts := []string{fmt.Sprintf("MERGE INTO %s USING (%s) %s ON (%s)",
+		table, source, mergeSource, strings.Join(on, " AND "))}
+
+	if matched != "" {
+		parts = append(parts, matched)
+	}
+
+	parts = append(parts, fmt.Sprintf("WHEN NOT MATCHED THEN INSERT (%s) VALUES (%s)",
+		strings.Join(ib.insertStatement.Columns, ", "), strings.Join(values, ", ")))
+
+	return strings.Join(parts, " ")
+}
+
+// renderMerge generates the MERGE statement of String with the dialect d.
+//
+// Parameters:
+//   - d: The dialect of the builder.
+//
+// Returns:
+//   - string: The MERGE statement.
+func (ib *InsertBuilder) renderMerge(d Dialect) string {
+	var rows []string
+	for _, row := range ib.rowStatement.Rows {
+		rows = append(rows, ib.mergeRow(row.items(d)))
+	}
+
+	source := strings.Join(rows, " UNION ALL ")
+	if source == "" {
+		source = ib.queryStatement.render(d)
+	}
+
+	return ib.merge(d, source, ib.conflictStatement.render(d))
+}
+
+// renderMergeArgs generates the MERGE statement of StringArgs with the dialect d,
+// appending the values of the source rows, the assignments and the WHERE clause to the arguments slice.
+//
+// Parameters:
+//   - d: The dialect of the builder.
+//   - args []any: A slice of arguments to be used in the statement.
+//
+// Returns:
+//   - string: The MERGE statement.
+//   - []any: The updated slice of arguments.
+//   - error: ErrNotSupported when the upsert cannot be expressed as a MERGE statement.
+func (ib *InsertBuilder) renderMergeArgs(d Dialect, args []any) (string, []any, error) {
+	var rows []string
+	for _, row := range ib.rowStatement.Rows {
+		var values []string
+
+		values, args = row.itemsArgs(d, args)
+		rows = append(rows, ib.mergeRow(values))
+	}
+
+	var err error
+
+	source := strings.Join(rows, " UNION ALL ")
+	if source == "" {
+		source, args, err = ib.queryStatement.renderArgs(d, args)
+		if err != nil {
+			return "", args, err
+		}
+	}
+
+	matched, args, err := ib.conflictStatement.renderArgs(d, args)
+	if err != nil {
+		return "", args, err
+	}
+
+	sql := ib.merge(d, source, matched)
+
+	// Oracle has no RETURNING clause without output binds.
+	_, args, err = ib.returningStatement.renderArgs(d, args)
+	if err != nil {
+		return "", args, err
+	}
+
+	return sql, args, nil
+}
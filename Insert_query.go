@@ -2,7 +2,7 @@ package fluentsql
 
 // InsertQuery represents a query that can be used as a subquery in an INSERT statement.
 type InsertQuery struct {
-	// Query stores any query information, typically expected to be a pointer to QueryBuilder.
+	// Query stores any query information, typically expected to be a pointer to QueryBuilder or CompoundBuilder.
 	Query any
 }
 
@@ -12,9 +12,19 @@ type InsertQuery struct {
 //   - string: The string representation of the query. If the Query is a QueryBuilder,
 //     it calls the QueryBuilder's String method; otherwise, it returns an empty string.
 func (q *InsertQuery) String() string {
+	return q.render(nil)
+}
+
+// render generates the SQL of String with the dialect d, the default dialect when nil.
+func (q *InsertQuery) render(d Dialect) string {
 	if queryBuilder, ok := q.Query.(*QueryBuilder); ok {
 		// Call the String method of QueryBuilder if Query is of type *QueryBuilder.
-		return queryBuilder.String()
+		return queryBuilder.render(d)
+	}
+
+	if compoundBuilder, ok := q.Query.(*CompoundBuilder); ok {
+		// Call the String method of CompoundBuilder if Query is of type *CompoundBuilder.
+		return compoundBuilder.render(d)
 	}
 
 	// Return an empty string if Query is not of type *QueryBuilder.
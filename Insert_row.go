@@ -5,6 +5,8 @@ import (
 	"strings"
 )
 
+// InsertRows represents the VALUES clause of an INSERT statement.
+// Oracle has no multi-row VALUES clause, several rows are selected from DUAL and combined with UNION ALL.
 type InsertRows struct {
 	Rows []InsertRow
 }
@@ -22,11 +24,20 @@ func (r *InsertRows) Append(values ...any) {
 // Returns:
 //   - string: The generated VALUES clause as a string.
 func (r *InsertRows) String() string {
+	return r.render(nil)
+}
+
+// render generates the SQL of String with the dialect d, the default dialect when nil.
+func (r *InsertRows) render(d Dialect) string {
 	var rowsStr []string
 
 	// Generate string representation for each row.
 	for _, row := range r.Rows {
-		rowsStr = append(rowsStr, row.String())
+		if r.dual(d) {
+			rowsStr = append(rowsStr, dualRow(row.items(d)))
+		} else {
+			rowsStr = append(rowsStr, row.render(d))
+		}
 	}
 
 	// Return empty string if no rows were appended.
@@ -34,9 +45,36 @@ func (r *InsertRows) String() string {
 		return ""
 	}
 
+	if r.dual(d) {
+		return strings.Join(rowsStr, " UNION ALL ")
+	}
+
 	return fmt.Sprintf("VALUES %s", strings.Join(rowsStr, ", "))
 }
 
+// dual reports whether the rows are selected from DUAL instead of a VALUES clause,
+// which Oracle limits to a single row.
+//
+// Parameters:
+//   - d: The dialect of the builder, the default dialect when nil.
+//
+// Returns:
+//   - bool: true for several rows on Oracle.
+func (r *InsertRows) dual(d Dialect) bool {
+	return len(r.Rows) > 1 && isDialect(d, Oracle)
+}
+
+// dualRow generates a row selected from DUAL.
+//
+// Parameters:
+//   - values []string: The SQL of the row's values.
+//
+// Returns:
+//   - string: E.g. "SELECT :1, :2 FROM dual".
+func dualRow(values []string) string {
+	return fmt.Sprintf("SELECT %s FROM dual", strings.Join(values, ", "))
+}
+
 type InsertRow struct {
 	Values []any
 }
@@ -46,6 +84,22 @@ type InsertRow struct {
 // Returns:
 //   - string: The string representation of the row's values, formatted as a SQL tuple.
 func (ir *InsertRow) String() string {
+	return ir.render(nil)
+}
+
+// render generates the SQL of String with the dialect d, the default dialect when nil.
+func (ir *InsertRow) render(d Dialect) string {
+	return fmt.Sprintf("(%s)", strings.Join(ir.items(d), ", "))
+}
+
+// items generates the string representation of each value of the row.
+//
+// Parameters:
+//   - d: The dialect of the builder, the default dialect when nil.
+//
+// Returns:
+//   - []string: The SQL representation of the values.
+func (ir *InsertRow) items(d Dialect) []string {
 	var rowStr []string
 
 	// Process each value in the row to generate its string representation.
@@ -53,12 +107,10 @@ func (ir *InsertRow) String() string {
 		// Check if the value is of type ValueField.
 		if colField, ok := col.(IValueField); ok {
 			rowStr = append(rowStr, colField.Value())
-		} else if colString, ok := col.(string); ok { // Handle string values.
-			rowStr = append(rowStr, "'"+colString+"'")
-		} else { // Handle other types (int, float, etc.).
-			rowStr = append(rowStr, fmt.Sprintf("%v", col))
+		} else { // Handle literal values (string, int, nil, etc.).
+			rowStr = append(rowStr, inline(d, col))
 		}
 	}
 
-	return fmt.Sprintf("(%s)", strings.Join(rowStr, ", "))
+	return rowStr
 }
@@ -0,0 +1,364 @@
+package fluentsql
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Aggregate represents an aggregate function call, e.g. COUNT(DISTINCT user_id) or
+// SUM(amount) FILTER (WHERE status = $1).
+// An Aggregate is an expression: it can be used as a select column, written with its alias,
+// and as a HAVING or ORDER BY field, written without it.
+//
+// Fields:
+//   - Function: The function name, e.g. "COUNT" or "STRING_AGG", translated for the dialect by the constructors.
+//   - Field: The aggregated operand, e.g. "*", a column name or an Expr.
+//   - Separator: The separator of STRING_AGG, written as a literal.
+//   - IsDistinct: Whether only the distinct values are aggregated (DISTINCT).
+//   - Order: The order of the aggregated values, e.g. for STRING_AGG and ARRAY_AGG.
+//   - Conditions: The conditions of the aggregated rows (FILTER), emulated with CASE by the dialects
+//     other than PostgreSQL and SQLite.
+//   - Name: The alias of the select column.
+type Aggregate struct {
+	Function   string
+	Field      any
+	Separator  string
+	IsDistinct bool
+	Order      OrderBy
+	Conditions []Condition
+	Name       string
+}
+
+// Function names of the aggregates whose name or syntax depends on the dialect.
+const (
+	stringAgg = "STRING_AGG"
+	arrayAgg  = "ARRAY_AGG"
+	boolAnd   = "BOOL_AND"
+	boolOr    = "BOOL_OR"
+)
+
+// Agg creates an aggregate function call.
+//
+// Parameters:
+//   - function: The function name, e.g. "STDDEV".
+//   - field: The aggregated operand, e.g. a column name.
+//
+// Returns:
+//   - *Aggregate: A pointer to a new Aggregate instance.
+func Agg(function string, field any) *Aggregate {
+	return &Aggregate{
+		Function: function,
+		Field:    field,
+	}
+}
+
+// Count creates a COUNT(field) aggregate, Count("*") counts the rows.
+func Count(field any) *Aggregate {
+	return Agg("COUNT", field)
+}
+
+// Sum creates a SUM(field) aggregate.
+func Sum(field any) *Aggregate {
+	return Agg("SUM", field)
+}
+
+// Avg creates an AVG(field) aggregate.
+func Avg(field any) *Aggregate {
+	return Agg("AVG", field)
+}
+
+// Min creates a MIN(field) aggregate.
+func Min(field any) *Aggregate {
+	return Agg("MIN", field)
+}
+
+// Max creates a MAX(field) aggregate.
+func Max(field any) *Aggregate {
+	return Agg("MAX", field)
+}
+
+// StringAgg creates an aggregate concatenating the values with a separator: STRING_AGG on PostgreSQL and
+// SQL Server, GROUP_CONCAT on MySQL and SQLite, LISTAGG on Oracle.
+func StringAgg(field any, separator string) *Aggregate {
+	aggregate := Agg(stringAgg, field)
+	aggregate.Separator = separator
+
+	return aggregate
+}
+
+// ArrayAgg creates an ARRAY_AGG(field) aggregate, groupArray on ClickHouse.
+func ArrayAgg(field any) *Aggregate {
+	return Agg(arrayAgg, field)
+}
+
+// BoolAnd creates a BOOL_AND(field) aggregate, true if all the values are true.
+// The dialects other than PostgreSQL use MIN, for boolean values stored as 0 and 1, converted to int on SQL Server.
+func BoolAnd(field any) *Aggregate {
+	return Agg(boolAnd, field)
+}
+
+// BoolOr creates a BOOL_OR(field) aggregate, true if any value is true.
+// The dialects other than PostgreSQL use MAX, for boolean values stored as 0 and 1, converted to int on SQL Server.
+func BoolOr(field any) *Aggregate {
+	return Agg(boolOr, field)
+}
+
+// Distinct aggregates the distinct values only.
+//
+// Returns:
+//   - *Aggregate: The current Aggregate instance.
+func (a *Aggregate) Distinct() *Aggregate {
+	a.IsDistinct = true
+
+	return a
+}
+
+// OrderBy adds a sort item ordering the aggregated values.
+//
+// Parameters:
+//   - field: The field to sort by.
+//   - dir: The direction of the sort.
+//
+// Returns:
+//   - *Aggregate: The current Aggregate instance.
+func (a *Aggregate) OrderBy(field any, dir OrderByDir) *Aggregate {
+	a.Order.Append(field, dir)
+
+	return a
+}
+
+// Filter adds a condition on the aggregated rows, joined with AND.
+//
+// Parameters:
+//   - field: The field of the condition.
+//   - opt: The operator.
+//   - value: The value of the condition.
+//
+// Returns:
+//   - *Aggregate: The current Aggregate instance.
+func (a *Aggregate) Filter(field any, opt WhereOpt, value any) *Aggregate {
+	a.Conditions = append(a.Conditions, Condition{
+		Field: field,
+		Opt:   opt,
+		Value: value,
+		AndOr: And,
+	})
+
+	return a
+}
+
+// FilterOr adds a condition on the aggregated rows, joined with OR.
+//
+// Parameters:
+//   - field: The field of the condition.
+//   - opt: The operator.
+//   - value: The value of the condition.
+//
+// Returns:
+//   - *Aggregate: The current Aggregate instance.
+func (a *Aggregate) FilterOr(field any, opt WhereOpt, value any) *Aggregate {
+	a.Conditions = append(a.Conditions, Condition{
+		Field: field,
+		Opt:   opt,
+		Value: value,
+		AndOr: Or,
+	})
+
+	return a
+}
+
+// AS sets the alias of the aggregate, written when it is a select column.
+//
+// Parameters:
+//   - alias: The alias to be used.
+//
+// Returns:
+//   - *Aggregate: The current Aggregate instance.
+func (a *Aggregate) AS(alias string) *Aggregate {
+	a.Name = alias
+
+	return a
+}
+
+// alias generates the alias suffix of the aggregate column.
+//
+// Returns:
+//   - string: " AS name" or an empty string.
+func (a *Aggregate) alias() string {
+	if a.Name == "" {
+		return ""
+	}
+
+	return " AS " + a.Name
+}
+
+// function returns the function name of the aggregate for the dialect d.
+func (a *Aggregate) function(d Dialect) string {
+	switch {
+	case a.Function == stringAgg && (isDialect(d, MySQL) || isDialect(d, SQLite)):
+		return "GROUP_CONCAT"
+	case a.Function == stringAgg && isDialect(d, Oracle):
+		return "LISTAGG"
+	case a.Function == arrayAgg && isDialect(d, ClickHouse):
+		return "groupArray"
+	case a.Function == boolAnd && !isDialect(d, PostgreSQL):
+		return "MIN"
+	case a.Function == boolOr && !isDialect(d, PostgreSQL):
+		return "MAX"
+	}
+
+	return a.Function
+}
+
+// filterCase returns the operand of the aggregate emulating the FILTER clause:
+// CASE WHEN conditions THEN field END, whose NULL results are ignored by the aggregate.
+func (a *Aggregate) filterCase() *Case {
+	var value any
+
+	switch field := a.Field.(type) {
+	case string:
+		if field == "*" {
+			field = "1"
+		}
+
+		value = ValueField(field)
+	default:
+		value = field
+	}
+
+	return &Case{WhenClauses: []WhenCase{{Conditions: a.Conditions, Value: value}}}
+}
+
+// String generates the SQL of the aggregate for the default dialect, without its alias.
+//
+// Returns:
+//   - string: E.g. "COUNT(DISTINCT user_id)".
+func (a *Aggregate) String() string {
+	return a.render(nil)
+}
+
+// render generates the SQL of String with the dialect d, the default dialect when nil.
+func (a *Aggregate) render(d Dialect) string {
+	sql, _, _ := a.build(d, nil, false)
+
+	return sql
+}
+
+// StringArgs generates the SQL of the aggregate, without its alias, and appends its parameters to the arguments slice.
+func (a *Aggregate) StringArgs(args []any) (string, []any) {
+	sql, args, _ := a.renderArgs(nil, args)
+
+	return sql, args
+}
+
+// renderArgs generates the SQL of StringArgs with the dialect d, the default dialect when nil.
+func (a *Aggregate) renderArgs(d Dialect, args []any) (string, []any, error) {
+	return a.build(d, args, true)
+}
+
+// build generates the SQL of the aggregate for the dialect d, in the order of its parameters.
+//
+// Parameters:
+//   - d: The dialect of the builder, the default dialect when nil.
+//   - args []any: A slice of arguments.
+//   - bind: Whether the values are bound as parameters, or inlined as literals.
+//
+// Returns:
+//   - string: The SQL of the aggregate.
+//   - []any: The updated slice of arguments.
+//   - error: The error of a nested query or expression, when bound.
+func (a *Aggregate) build(d Dialect, args []any, bind bool) (string, []any, error) {
+	d = dialectOr(d)
+
+	var sql, order string
+	var err error
+
+	// FILTER is native on PostgreSQL and SQLite, emulated with CASE by the other dialects.
+	nativeFilter := isDialect(d, PostgreSQL) || isDialect(d, SQLite)
+
+	var value any = a.Field
+	if len(a.Conditions) > 0 && !nativeFilter {
+		value = a.filterCase()
+	}
+
+	// SQL Server has no MIN / MAX of bit values, they are converted to int.
+	if (a.Function == boolAnd || a.Function == boolOr) && isDialect(d, SQLServer) {
+		value = Cast(value, "int")
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString(a.function(d) + "(")
+
+	if a.IsDistinct {
+		sb.WriteString("DISTINCT ")
+	}
+
+	if bind {
+		sql, args, err = operandArgs(d, value, args)
+		if err != nil {
+			return "", args, err
+		}
+	} else {
+		sql = operand(d, value)
+	}
+
+	sb.WriteString(sql)
+
+	// The ORDER BY parameters come after the value, wherever the dialect writes the clause.
+	if bind {
+		order, args, err = a.Order.renderArgs(d, args)
+		if err != nil {
+			return "", args, err
+		}
+	} else {
+		order = a.Order.render(d)
+	}
+
+	switch {
+	case a.Function == stringAgg && isDialect(d, MySQL):
+		// GROUP_CONCAT(value ORDER BY ... SEPARATOR ', ')
+		if len(a.Order.Items) > 0 {
+			sb.WriteString(" " + order)
+		}
+
+		sb.WriteString(" SEPARATOR " + inline(d, a.Separator) + ")")
+	case a.Function == stringAgg && (isDialect(d, SQLServer) || isDialect(d, Oracle)):
+		// STRING_AGG(value, ', ') WITHIN GROUP (ORDER BY ...)
+		sb.WriteString(", " + inline(d, a.Separator) + ")")
+
+		if len(a.Order.Items) > 0 {
+			sb.WriteString(" WITHIN GROUP (" + order + ")")
+		}
+	default:
+		if a.Function == stringAgg {
+			sb.WriteString(", " + inline(d, a.Separator))
+		}
+
+		if len(a.Order.Items) > 0 {
+			sb.WriteString(" " + order)
+		}
+
+		sb.WriteString(")")
+	}
+
+	if len(a.Conditions) > 0 && nativeFilter {
+		var items []string
+		for _, condition := range a.Conditions {
+			if bind {
+				sql, args, err = condition.renderArgs(d, args)
+				if err != nil {
+					return "", args, err
+				}
+			} else {
+				sql = condition.render(d)
+			}
+
+			items = append(items, sql)
+		}
+
+		sb.WriteString(fmt.Sprintf(" FILTER (WHERE %s)", joinConditions(a.Conditions, items)))
+	}
+
+	return sb.String(), args, nil
+}
@@ -0,0 +1,122 @@
+package fluentsql
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// TestAggregate
+func TestAggregate(t *testing.T) {
+	testCases := []struct {
+		dialect   Dialect
+		aggregate *Aggregate
+		expected  string
+	}{
+		{new(PostgreSQLDialect), Count("*"), "COUNT(*)"},
+		{new(PostgreSQLDialect), Count("user_id").Distinct(), "COUNT(DISTINCT user_id)"},
+		{new(PostgreSQLDialect), Sum(Mul("price", "qty")), "SUM(price * qty)"},
+		{new(PostgreSQLDialect), Avg("salary").AS("average"), "AVG(salary)"},
+		{new(PostgreSQLDialect), Min("created_at"), "MIN(created_at)"},
+		{new(PostgreSQLDialect), Max(Ident("order")), `MAX("order")`},
+		{new(PostgreSQLDialect), Agg("STDDEV", "salary"), "STDDEV(salary)"},
+		{new(PostgreSQLDialect), StringAgg("name", ", ").OrderBy("name", Asc), "STRING_AGG(name, ', ' ORDER BY name ASC)"},
+		{new(MySQLDialect), StringAgg("name", ", ").Distinct().OrderBy("name", Desc), "GROUP_CONCAT(DISTINCT name ORDER BY name DESC SEPARATOR ', ')"},
+		{new(SQLiteDialect), StringAgg("name", ","), "GROUP_CONCAT(name, ',')"},
+		{new(SQLServerDialect), StringAgg("name", ", ").OrderBy("name", Asc), "STRING_AGG(name, ', ') WITHIN GROUP (ORDER BY name ASC)"},
+		{new(OracleDialect), StringAgg("name", ", ").OrderBy("name", Asc), "LISTAGG(name, ', ') WITHIN GROUP (ORDER BY name ASC)"},
+		{new(PostgreSQLDialect), ArrayAgg("id").OrderBy("id", Desc), "ARRAY_AGG(id ORDER BY id DESC)"},
+		{new(ClickHouseDialect), ArrayAgg("id"), "groupArray(id)"},
+		{new(PostgreSQLDialect), BoolAnd("active"), "BOOL_AND(active)"},
+		{new(MySQLDialect), BoolAnd("active"), "MIN(active)"},
+		{new(SQLiteDialect), BoolOr("active"), "MAX(active)"},
+		{new(SQLServerDialect), BoolAnd("active"), "MIN(CAST(active AS int))"},
+		{new(SQLServerDialect), BoolOr("active").Filter("status", Eq, "paid"), "MAX(CAST(CASE WHEN status = 'paid' THEN active END AS int))"},
+		{new(PostgreSQLDialect), Count("*").Filter("status", Eq, "paid"), "COUNT(*) FILTER (WHERE status = 'paid')"},
+		{new(SQLiteDialect), Sum("amount").Filter("status", Eq, "paid").FilterOr("refunded", Eq, false),
+			"SUM(amount) FILTER (WHERE status = 'paid' OR refunded = false)"},
+		{new(MySQLDialect), Count("*").Filter("status", Eq, "paid"), "COUNT(CASE WHEN status = 'paid' THEN 1 END)"},
+		{new(MySQLDialect), Count("user_id").Distinct().Filter("amount", Greater, 100),
+			"COUNT(DISTINCT CASE WHEN amount > 100 THEN user_id END)"},
+		{new(SQLServerDialect), Sum(Mul("price", "qty")).Filter("status", Eq, "paid"),
+			"SUM(CASE WHEN status = 'paid' THEN price * qty END)"},
+	}
+
+	for _, testCase := range testCases {
+		if sql := testCase.aggregate.render(testCase.dialect); sql != testCase.expected {
+			t.Fatalf(`Query %s != %s`, sql, testCase.expected)
+		}
+	}
+}
+
+// TestAggregateArgs
+func TestAggregateArgs(t *testing.T) {
+	sql, args, _ := Sum("amount").Filter("status", Eq, "paid").renderArgs(new(PostgreSQLDialect), []any{1})
+	if sql != "SUM(amount) FILTER (WHERE status = $2)" || fmt.Sprint(args) != "[1 paid]" {
+		t.Fatalf("Unexpected query %s %v", sql, args)
+	}
+
+	// The condition of the emulated FILTER comes before the aggregated value
+	sql, args, _ = Sum(Mul("price", Arg(2))).Filter("status", Eq, "paid").renderArgs(new(SQLServerDialect), nil)
+	if sql != "SUM(CASE WHEN status = @p1 THEN price * @p2 END)" || fmt.Sprint(args) != "[paid 2]" {
+		t.Fatalf("Unexpected query %s %v", sql, args)
+	}
+
+	sql, args, _ = StringAgg("name", ", ").OrderBy(Func("LOWER", Arg("x")), Asc).Filter("active", Eq, true).renderArgs(new(MySQLDialect), nil)
+	expected := "GROUP_CONCAT(CASE WHEN active = ? THEN name END ORDER BY LOWER(?) ASC SEPARATOR ', ')"
+	if sql != expected || fmt.Sprint(args) != "[true x]" {
+		t.Fatalf(`Query %s != %s %v`, sql, expected, args)
+	}
+}
+
+// TestAggregateStatement
+func TestAggregateStatement(t *testing.T) {
+	total := Sum("amount").Filter("status", Eq, "paid").AS("total")
+
+	sql, args, err := QueryInstance().
+		SetDialect(new(PostgreSQLDialect)).
+		Select("customer_id", Count("*").AS("orders"), total, StringAgg("sku", ",").OrderBy("sku", Asc).AS("skus")).
+		From("orders").
+		GroupBy("customer_id").
+		Having(total, Greater, 1000).
+		Having(Count("*"), GrEq, 3).
+		OrderBy(total, Desc).
+		Sql()
+
+	expected := "SELECT customer_id, COUNT(*) AS orders, SUM(amount) FILTER (WHERE status = $1) AS total, " +
+		"STRING_AGG(sku, ',' ORDER BY sku ASC) AS skus FROM orders GROUP BY customer_id " +
+		"HAVING SUM(amount) FILTER (WHERE status = $2) > $3 AND COUNT(*) >= $4 " +
+		"ORDER BY SUM(amount) FILTER (WHERE status = $5) DESC"
+	if err != nil || sql != expected {
+		t.Fatalf(`Query %s != %s (%v)`, sql, expected, err)
+	}
+
+	if fmt.Sprint(args) != "[paid paid 1000 3 paid]" {
+		t.Fatalf("Unexpected arguments %v", args)
+	}
+
+	sql, args, _ = QueryInstance().
+		SetDialect(new(MySQLDialect)).
+		Select("customer_id", total).
+		From("orders").
+		GroupBy("customer_id").
+		Having(total, Greater, 1000).
+		Sql()
+
+	expected = "SELECT customer_id, SUM(CASE WHEN status = ? THEN amount END) AS total FROM orders GROUP BY customer_id " +
+		"HAVING SUM(CASE WHEN status = ? THEN amount END) > ?"
+	if sql != expected || fmt.Sprint(args) != "[paid paid 1000]" {
+		t.Fatalf(`Query %s != %s %v`, sql, expected, args)
+	}
+
+	// Strict mode validates the field and the conditions of the aggregate
+	_, _, err = QueryInstance().
+		SetDialect(Strict(new(PostgreSQLDialect))).
+		From("orders").
+		GroupBy("customer_id").
+		Having(Count("*").Filter("status = 'paid' --", Eq, 1), Greater, 1).
+		Sql()
+	if !errors.Is(err, ErrUnsafeIdent) {
+		t.Fatalf("Expected ErrUnsafeIdent, got %v", err)
+	}
+}
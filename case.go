@@ -6,11 +6,14 @@ import (
 )
 
 type Case struct {
-	// Exp specifies the expression to be evaluated in the CASE statement.
-	Exp string
+	// Exp specifies the expression compared with the WHEN values of a simple CASE: a string written as is,
+	// or an Expr. It is empty for a searched CASE, whose WHEN clauses hold conditions.
+	Exp any
 	// WhenClauses is a list of WHEN clauses defined for the CASE statement.
 	WhenClauses []WhenCase
-	// Name is the alias for the CASE statement.
+	// ElseValue is the result when no WHEN clause matches, NULL when nil.
+	ElseValue any
+	// Name is the alias for the CASE statement, omitted when empty, e.g. in a condition or an ORDER BY clause.
 	Name string
 }
 
@@ -33,18 +36,21 @@ Search CASE expression
        WHEN salary < 3000 THEN 'Low'
        WHEN salary >= 3000 AND salary <= 5000 THEN 'Average'
        WHEN salary > 5000 THEN 'High'
+       ELSE 'Unknown'
    END evaluation
 */
 
 // FieldCase creates a new Case instance with the provided expression and name.
+// A Case is an expression: without a name, it can be used as a condition field or value, an ORDER BY field
+// or a SET value.
 //
 // Parameters:
-//   - exp: The expression to be evaluated in the CASE clause.
-//   - name: The alias for the CASE clause.
+//   - exp: The expression to be evaluated in the CASE clause, empty for a searched CASE.
+//   - name: The alias for the CASE clause, empty for none.
 //
 // Returns:
 //   - *Case: A pointer to a new Case instance.
-func FieldCase(exp, name string) *Case {
+func FieldCase(exp any, name string) *Case {
 	return &Case{
 		Exp:  exp,
 		Name: name,
@@ -54,12 +60,15 @@ func FieldCase(exp, name string) *Case {
 // When appends a new WHEN clause to the Case instance.
 //
 // Parameters:
-//   - conditions: The condition(s) to evaluate (can be a single value, string, or a slice of Condition).
-//   - value: The value to return when the condition is met.
+//   - conditions: The value compared with the expression of a simple CASE, written as a literal unless it is
+//     an Expr, or the condition(s) of a searched CASE: a Condition or a slice of Condition, joined with AND,
+//     or with OR for the conditions of type Or.
+//   - value: The value to return when the condition is met. Strings are values, bound as parameters by
+//     StringArgs, use an Expr or a ValueField for a column.
 //
 // Returns:
 //   - *Case: A pointer to the Case instance, for method chaining.
-func (c *Case) When(conditions any, value string) *Case {
+func (c *Case) When(conditions any, value any) *Case {
 	c.WhenClauses = append(c.WhenClauses, WhenCase{
 		Conditions: conditions,
 		Value:      value,
@@ -68,11 +77,105 @@ func (c *Case) When(conditions any, value string) *Case {
 	return c
 }
 
+// Else sets the result of the CASE when no WHEN clause matches.
+//
+// Parameters:
+//   - value: The value to return, with the rules of the WHEN values.
+//
+// Returns:
+//   - *Case: A pointer to the Case instance, for method chaining.
+func (c *Case) Else(value any) *Case {
+	c.ElseValue = value
+
+	return c
+}
+
 type WhenCase struct {
-	// Conditions represents the condition(s) evaluated in the WHEN clause. It can be a string, integer, or slice of Condition.
+	// Conditions represents the condition(s) evaluated in the WHEN clause. It can be a Condition or a slice of
+	// Condition for a searched CASE, or a value compared with the expression of a simple CASE.
 	Conditions any
 	// Value represents the result to return when the conditions are met.
-	Value string
+	Value any
+}
+
+// conditions returns the conditions of a searched CASE WHEN clause.
+//
+// Returns:
+//   - []Condition: The conditions.
+//   - bool: false if the WHEN clause holds a value of a simple CASE.
+func (c *WhenCase) conditions() ([]Condition, bool) {
+	switch conditions := c.Conditions.(type) {
+	case []Condition:
+		return conditions, true
+	case Condition:
+		return []Condition{conditions}, true
+	}
+
+	return nil, false
+}
+
+// joinConditions joins the SQL of the conditions of a WHEN clause with AND, or with OR for the conditions of type Or.
+//
+// Parameters:
+//   - conditions: The conditions.
+//   - items: The SQL of each condition.
+//
+// Returns:
+//   - string: The joined conditions.
+func joinConditions(conditions []Condition, items []string) string {
+	var sb strings.Builder
+
+	for i, item := range items {
+		if i > 0 {
+			if conditions[i].AndOr == Or {
+				sb.WriteString(" OR ")
+			} else {
+				sb.WriteString(" AND ")
+			}
+		}
+
+		sb.WriteString(item)
+	}
+
+	return sb.String()
+}
+
+// caseValue generates the SQL of a THEN or ELSE value with the values inlined. Strings are values, not columns.
+//
+// Parameters:
+//   - d: The dialect of the builder, the default dialect when nil.
+//   - value: The value.
+//
+// Returns:
+//   - string: The SQL of the value.
+func caseValue(d Dialect, value any) string {
+	if _, ok := value.(string); ok {
+		return inline(d, value)
+	}
+
+	return operand(d, value)
+}
+
+// caseValueArgs generates the SQL of a THEN or ELSE value and appends its parameters to the arguments slice.
+// Strings are values, not columns.
+//
+// Parameters:
+//   - d: The dialect of the builder, the default dialect when nil.
+//   - value: The value.
+//   - args []any: A slice of arguments.
+//
+// Returns:
+//   - string: The SQL of the value.
+//   - []any: The updated slice of arguments.
+//   - error: The error of a nested query or expression.
+func caseValueArgs(d Dialect, value any, args []any) (string, []any, error) {
+	if _, ok := value.(string); ok {
+		args = append(args, value)
+
+		return p(d, args), args, nil
+	}
+
+	return operandArgs(d, value, args)
 }
 
 // String generates the SQL representation of the WHEN clause.
@@ -80,16 +183,21 @@ type WhenCase struct {
 // Returns:
 //   - string: The SQL string of the WHEN clause.
 func (c *WhenCase) String() string {
-	if valueConditions, ok := c.Conditions.([]Condition); ok {
+	return c.render(nil)
+}
+
+// render generates the SQL of String with the dialect d, the default dialect when nil.
+func (c *WhenCase) render(d Dialect) string {
+	if valueConditions, ok := c.conditions(); ok {
 		var cons []string
 		for _, condition := range valueConditions {
-			cons = append(cons, condition.String())
+			cons = append(cons, condition.render(d))
 		}
 
-		return fmt.Sprintf("WHEN %s THEN '%s'", strings.Join(cons, " AND "), c.Value)
+		return fmt.Sprintf("WHEN %s THEN %s", joinConditions(valueConditions, cons), caseValue(d, c.Value))
 	}
 
-	return fmt.Sprintf("WHEN %v THEN '%s'", c.Conditions, c.Value)
+	return fmt.Sprintf("WHEN %s THEN %s", operand(d, c.Conditions), caseValue(d, c.Value))
 }
 
 // String generates the SQL representation of the entire CASE statement.
@@ -97,11 +205,30 @@ func (c *WhenCase) String() string {
 // Returns:
 //   - string: The SQL string of the CASE statement.
 func (c *Case) String() string {
-	var whenCases []string
+	return c.render(nil)
+}
+
+// render generates the SQL of String with the dialect d, the default dialect when nil.
+func (c *Case) render(d Dialect) string {
+	parts := []string{"CASE"}
+
+	if c.Exp != nil && c.Exp != "" {
+		parts = append(parts, operand(d, c.Exp))
+	}
 
 	for _, whenClause := range c.WhenClauses {
-		whenCases = append(whenCases, whenClause.String())
+		parts = append(parts, whenClause.render(d))
+	}
+
+	if c.ElseValue != nil {
+		parts = append(parts, "ELSE "+caseValue(d, c.ElseValue))
+	}
+
+	parts = append(parts, "END")
+
+	if c.Name != "" {
+		parts = append(parts, c.Name)
 	}
 
-	return fmt.Sprintf("CASE %s %s END %s", c.Exp, strings.Join(whenCases, " "), c.Name)
+	return strings.Join(parts, " ")
 }
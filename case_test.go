@@ -1,6 +1,10 @@
 package fluentsql
 
-import "testing"
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
 
 // TestCaseSimple
 /*
@@ -80,9 +84,93 @@ func TestCaseSearch(t *testing.T) {
 	caseTest.When(conditionsHigh, "High")
 	caseTest.Name = "evaluation"
 
-	expected := "CASE  WHEN salary < 3000 THEN 'Low' WHEN salary >= 3000 AND salary <= 5000 THEN 'Average' WHEN salary > 5000 THEN 'High' END evaluation"
+	expected := "CASE WHEN salary < 3000 THEN 'Low' WHEN salary >= 3000 AND salary <= 5000 THEN 'Average' WHEN salary > 5000 THEN 'High' END evaluation"
 
 	if caseTest.String() != expected {
 		t.Fatalf(`Query %s != %s`, caseTest.String(), expected)
 	}
 }
+
+// TestCaseElse
+func TestCaseElse(t *testing.T) {
+	caseTest := FieldCase("", "evaluation").
+		When([]Condition{
+			{Field: "salary", Opt: Lesser, Value: 3000},
+			{Field: "bonus", Opt: Null, AndOr: Or},
+		}, "Low").
+		When(Condition{Field: "salary", Opt: Greater, Value: 5000}, "High").
+		Else("Average")
+
+	expected := "CASE WHEN salary < 3000 OR bonus IS NULL THEN 'Low' WHEN salary > 5000 THEN 'High' ELSE 'Average' END evaluation"
+	if caseTest.String() != expected {
+		t.Fatalf(`Query %s != %s`, caseTest.String(), expected)
+	}
+
+	sql, args, _ := caseTest.renderArgs(new(MySQLDialect), nil)
+
+	expected = "CASE WHEN salary < ? OR bonus IS NULL THEN ? WHEN salary > ? THEN ? ELSE ? END evaluation"
+	if sql != expected || fmt.Sprint(args) != "[3000 Low 5000 High Average]" {
+		t.Fatalf(`Query %s != %s %v`, sql, expected, args)
+	}
+
+	// Simple CASE on an expression, with column results
+	caseTest = FieldCase(Func("LOWER", "status"), "").
+		When("'open'", ValueField("opened_at")).
+		When(Arg("closed"), ValueField("closed_at")).
+		Else(nil)
+
+	sql, args, _ = caseTest.renderArgs(new(PostgreSQLDialect), nil)
+
+	expected = "CASE LOWER(status) WHEN 'open' THEN opened_at WHEN $1 THEN closed_at END"
+	if sql != expected || fmt.Sprint(args) != "[closed]" {
+		t.Fatalf(`Query %s != %s %v`, sql, expected, args)
+	}
+}
+
+// TestCaseStatement
+func TestCaseStatement(t *testing.T) {
+	priority := FieldCase("status", "").When("'urgent'", 1).When("'normal'", 2).Else(3)
+
+	sql, args, err := QueryInstance().
+		SetDialect(new(PostgreSQLDialect)).
+		Select("id").
+		From("tickets").
+		Where(priority, LeEq, 2).
+		Where("level", Eq, FieldCase("", "").When(Condition{Field: "vip", Opt: Eq, Value: true}, "gold").Else("silver")).
+		OrderBy(priority, Asc).
+		Sql()
+
+	expected := "SELECT id FROM tickets WHERE CASE status WHEN 'urgent' THEN $1 WHEN 'normal' THEN $2 ELSE $3 END <= $4 " +
+		"AND level = CASE WHEN vip = $5 THEN $6 ELSE $7 END " +
+		"ORDER BY CASE status WHEN 'urgent' THEN $8 WHEN 'normal' THEN $9 ELSE $10 END ASC"
+	if err != nil || sql != expected {
+		t.Fatalf(`Query %s != %s (%v)`, sql, expected, err)
+	}
+
+	if fmt.Sprint(args) != "[1 2 3 2 true gold silver 1 2 3]" {
+		t.Fatalf("Unexpected arguments %v", args)
+	}
+
+	sql, args, err = UpdateInstance().
+		SetDialect(new(SQLServerDialect)).
+		Update("employees").
+		Set("salary", FieldCase("", "").
+			When(Condition{Field: "rating", Opt: GrEq, Value: 4}, Mul("salary", 1.1)).
+			Else(ValueField("salary"))).
+		Sql()
+
+	expected = "UPDATE employees SET salary = CASE WHEN rating >= @p1 THEN salary * @p2 ELSE salary END"
+	if err != nil || sql != expected || fmt.Sprint(args) != "[4 1.1]" {
+		t.Fatalf(`Query %s != %s %v (%v)`, sql, expected, args, err)
+	}
+
+	// Strict mode validates the fields of the WHEN conditions
+	_, _, err = QueryInstance().
+		SetDialect(Strict(new(PostgreSQLDialect))).
+		From("tickets").
+		Where(FieldCase("", "").When(Condition{Field: "1=1; --", Opt: Eq, Value: 1}, 1), Eq, 1).
+		Sql()
+	if !errors.Is(err, ErrUnsafeIdent) {
+		t.Fatalf("Expected ErrUnsafeIdent, got %v", err)
+	}
+}
@@ -0,0 +1,421 @@
+package fluentsql
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ====================================================================
+//                   Compound Builder :: Structure
+// ====================================================================
+
+// SetOpt defines the set operators used to combine queries.
+type SetOpt int
+
+const (
+	Union        SetOpt = iota // Distinct rows of both queries (UNION)
+	UnionAll                   // All rows of both queries (UNION ALL)
+	Intersect                  // Rows present in both queries (INTERSECT)
+	IntersectAll               // Rows present in both queries, keeping duplicates (INTERSECT ALL)
+	Except                     // Rows of the first query not present in the second (EXCEPT)
+	ExceptAll                  // Rows of the first query not present in the second, keeping duplicates (EXCEPT ALL)
+)
+
+// CompoundItem represents a single query of a compound statement.
+// Fields:
+//   - Opt: The set operator combining the query with the previous one. Ignored for the first item.
+//   - Query: The combined query, a *QueryBuilder or a nested *CompoundBuilder.
+type CompoundItem struct {
+	Opt   SetOpt
+	Query any
+}
+
+// opt returns the SQL set operator as a string based on the SetOpt.
+//
+// Parameters:
+//   - d: The dialect of the builder, Oracle writes EXCEPT as MINUS.
+//
+// Returns:
+//   - string: The SQL set operator ("UNION", "UNION ALL", etc.).
+func (c *CompoundItem) opt(d Dialect) string {
+	var sign string
+
+	switch c.Opt {
+	case Union:
+		sign = "UNION"
+	case UnionAll:
+		sign = "UNION ALL"
+	case Intersect:
+		sign = "INTERSECT"
+	case IntersectAll:
+		sign = "INTERSECT ALL"
+	case Except:
+		sign = "EXCEPT"
+	case ExceptAll:
+		sign = "EXCEPT ALL"
+	}
+
+	if isDialect(d, Oracle) {
+		sign = strings.Replace(sign, "EXCEPT", "MINUS", 1)
+	}
+
+	return sign
+}
+
+// parenthesized reports whether the query of the item has to be wrapped in parentheses.
+// Nested compound queries and queries with their own ORDER BY, LIMIT or FETCH clause
+// would otherwise change the meaning of the whole statement.
+//
+// Returns:
+//   - bool: true if the query must be wrapped in parentheses.
+func (c *CompoundItem) parenthesized() bool {
+	switch query := c.Query.(type) {
+	case *CompoundBuilder:
+		return true
+	case *QueryBuilder:
+		return len(query.orderByStatement.Items) > 0 ||
+			query.limitStatement.String() != "" ||
+			query.fetchStatement.String() != ""
+	}
+
+	return false
+}
+
+// wrap wraps the SQL of the query in parentheses when required. SQLite does not accept
+// a parenthesized query as member of a compound statement, it is selected from instead.
+//
+// Parameters:
+//   - d: The dialect of the builder.
+//   - sql: The SQL of the query.
+//
+// Returns:
+//   - string: The SQL of the query, wrapped when required.
+func (c *CompoundItem) wrap(d Dialect, sql string) string {
+	if !c.parenthesized() {
+		return sql
+	}
+
+	if isDialect(d, SQLite) {
+		return fmt.Sprintf("SELECT * FROM (%s)", sql)
+	}
+
+	return fmt.Sprintf("(%s)", sql)
+}
+
+// String generates the SQL representation of the query of the item.
+//
+// Returns:
+//   - string: The SQL query, wrapped in parentheses when required.
+func (c *CompoundItem) String() string {
+	return c.render(nil)
+}
+
+// render generates the SQL of String with the dialect d, the default dialect when nil.
+func (c *CompoundItem) render(d Dialect) string {
+	var sql string
+
+	switch query := c.Query.(type) {
+	case *QueryBuilder:
+		sql = query.render(d)
+	case *CompoundBuilder:
+		sql = query.render(d)
+	}
+
+	return c.wrap(d, sql)
+}
+
+// CompoundBuilder struct represents a builder for combining queries with set operators.
+//
+// Syntax:
+//
+//	query { UNION | UNION ALL | INTERSECT [ALL] | EXCEPT [ALL] } query ...
+//	[ORDER BY ...]
+//	[LIMIT {[offset,] row_count | row_count OFFSET offset}]
+//
+// It can be used as a subquery in FROM, WHERE values and SELECT columns.
+type CompoundBuilder struct {
+	// dialect overrides the default dialect when set. Combined queries use the dialect of the compound query.
+	dialect Dialect
+	// alias defines an optional alias for the compound query.
+	alias string
+	// items holds the combined queries with their set operators.
+	items []CompoundItem
+	// orderByStatement represents the ORDER BY clause applied to the whole result.
+	orderByStatement OrderBy
+	// limitStatement represents the LIMIT clause applied to the whole result.
+	limitStatement Limit
+	// fetchStatement represents a FETCH clause, an alternative to LIMIT.
+	fetchStatement Fetch
+}
+
+// CompoundInstance creates a new instance of CompoundBuilder starting with the given query.
+//
+// Parameters:
+//   - query (any): The first query of the compound statement, a *QueryBuilder or *CompoundBuilder.
+//
+// Returns:
+//   - *CompoundBuilder: A pointer to a new CompoundBuilder instance.
+func CompoundInstance(query any) *CompoundBuilder {
+	return &CompoundBuilder{
+		items: []CompoundItem{{Query: query}},
+	}
+}
+
+// ====================================================================
+//                   Compound Builder :: Operators
+// ====================================================================
+
+// String generates the compound SQL query as a string.
+//
+// Returns:
+//   - string: The SQL representation of the compound query.
+func (cb *CompoundBuilder) String() string {
+	return cb.render(cb.dialect)
+}
+
+// render generates the SQL of String with the dialect d, the dialect of the enclosing
+// statement for a subquery. The default dialect is used when d is nil.
+func (cb *CompoundBuilder) render(d Dialect) string {
+	d = dialectOr(d)
+
+	var queryParts []string
+
+	// Append every query with its set operator
+	for i, item := range cb.items {
+		if i > 0 {
+			queryParts = append(queryParts, item.opt(d))
+		}
+
+		queryParts = append(queryParts, item.render(d))
+	}
+
+	limitSql := cb.limitStatement.render(d)
+	fetchSql := cb.fetchStatement.String()
+
+	// Append ORDER BY clause
+	orderBySql := pagingOrderBy(d, cb.orderByStatement.render(d), limitSql != "" || fetchSql != "")
+	if orderBySql != "" {
+		queryParts = append(queryParts, orderBySql)
+	}
+
+	// Append LIMIT clause
+	if limitSql != "" {
+		queryParts = append(queryParts, limitSql)
+	}
+
+	// Append FETCH clause
+	if fetchSql != "" {
+		queryParts = append(queryParts, fetchSql)
+	}
+
+	sql := strings.Join(queryParts, " ")
+
+	// Add alias if provided
+	if cb.alias != "" {
+		sql = d.SubqueryAlias("("+sql+")", cb.alias)
+	}
+
+	return sql
+}
+
+// append adds a query combined with the given set operator.
+//
+// Parameters:
+//   - opt (SetOpt): The set operator.
+//   - query (any): The query to combine, a *QueryBuilder or *CompoundBuilder.
+//
+// Returns:
+//   - *CompoundBuilder: The current CompoundBuilder instance.
+func (cb *CompoundBuilder) append(opt SetOpt, query any) *CompoundBuilder {
+	cb.items = append(cb.items, CompoundItem{
+		Opt:   opt,
+		Query: query,
+	})
+
+	return cb
+}
+
+// Union combines the query using UNION.
+//
+// Parameters:
+//   - query (any): The query to combine, a *QueryBuilder or *CompoundBuilder.
+//
+// Returns:
+//   - *CompoundBuilder: The current CompoundBuilder instance.
+func (cb *CompoundBuilder) Union(query any) *CompoundBuilder {
+	return cb.append(Union, query)
+}
+
+// UnionAll combines the query using UNION ALL.
+//
+// Parameters:
+//   - query (any): The query to combine, a *QueryBuilder or *CompoundBuilder.
+//
+// Returns:
+//   - *CompoundBuilder: The current CompoundBuilder instance.
+func (cb *CompoundBuilder) UnionAll(query any) *CompoundBuilder {
+	return cb.append(UnionAll, query)
+}
+
+// Intersect combines the query using INTERSECT.
+//
+// Parameters:
+//   - query (any): The query to combine, a *QueryBuilder or *CompoundBuilder.
+//
+// Returns:
+//   - *CompoundBuilder: The current CompoundBuilder instance.
+func (cb *CompoundBuilder) Intersect(query any) *CompoundBuilder {
+	return cb.append(Intersect, query)
+}
+
+// IntersectAll combines the query using INTERSECT ALL.
+//
+// Parameters:
+//   - query (any): The query to combine, a *QueryBuilder or *CompoundBuilder.
+//
+// Returns:
+//   - *CompoundBuilder: The current CompoundBuilder instance.
+func (cb *CompoundBuilder) IntersectAll(query any) *CompoundBuilder {
+	return cb.append(IntersectAll, query)
+}
+
+// Except combines the query using EXCEPT, written MINUS on Oracle.
+//
+// Parameters:
+//   - query (any): The query to combine, a *QueryBuilder or *CompoundBuilder.
+//
+// Returns:
+//   - *CompoundBuilder: The current CompoundBuilder instance.
+func (cb *CompoundBuilder) Except(query any) *CompoundBuilder {
+	return cb.append(Except, query)
+}
+
+// ExceptAll combines the query using EXCEPT ALL, written MINUS ALL on Oracle.
+//
+// Parameters:
+//   - query (any): The query to combine, a *QueryBuilder or *CompoundBuilder.
+//
+// Returns:
+//   - *CompoundBuilder: The current CompoundBuilder instance.
+func (cb *CompoundBuilder) ExceptAll(query any) *CompoundBuilder {
+	return cb.append(ExceptAll, query)
+}
+
+// OrderBy defines the ORDER BY clause applied to the result of the compound query.
+//
+// Parameters:
+//   - field (any): The field to sort by, a string, an Ident, an expression or the position of a column.
+//   - dir (OrderByDir): The direction of sorting (ASC or DESC).
+//
+// Returns:
+//   - *CompoundBuilder: The current CompoundBuilder instance.
+func (cb *CompoundBuilder) OrderBy(field any, dir OrderByDir) *CompoundBuilder {
+	cb.orderByStatement.Append(field, dir)
+
+	return cb
+}
+
+// OrderBySort appends sort items with a NULLS position or a collation to the ORDER BY clause of the compound query.
+//
+// Parameters:
+//   - items (...SortItem): The sort items, e.g. Sort("name", Asc).Collate(`"C"`).
+//
+// Returns:
+//   - *CompoundBuilder: The current CompoundBuilder instance.
+func (cb *CompoundBuilder) OrderBySort(items ...SortItem) *CompoundBuilder {
+	cb.orderByStatement.Items = append(cb.orderByStatement.Items, items...)
+
+	return cb
+}
+
+// Limit sets the LIMIT clause applied to the result of the compound query.
+//
+// Parameters:
+//   - limit (int): The maximum number of rows to return.
+//   - offset (int): The number of rows to skip.
+//
+// Returns:
+//   - *CompoundBuilder: The current CompoundBuilder instance.
+func (cb *CompoundBuilder) Limit(limit, offset int) *CompoundBuilder {
+	cb.limitStatement.Limit = limit
+	cb.limitStatement.Offset = offset
+
+	return cb
+}
+
+// LimitParam sets the LIMIT clause with named parameters, bound when the statement is executed.
+//
+// Parameters:
+// - limit Param: The parameter of the maximum number of rows to return.
+// - offset Param: The parameter of the number of rows to skip, or an empty Param for no offset.
+//
+// Returns:
+// - *CompoundBuilder: The CompoundBuilder instance with updated LIMIT clause.
+func (cb *CompoundBuilder) LimitParam(limit, offset Param) *CompoundBuilder {
+	cb.limitStatement.LimitParam = limit
+	cb.limitStatement.OffsetParam = offset
+
+	return cb
+}
+
+// Fetch sets the FETCH clause applied to the result of the compound query.
+//
+// Parameters:
+//   - offset (int): The number of rows to skip.
+//   - fetch (int): The number of rows to fetch.
+//
+// Returns:
+//   - *CompoundBuilder: The current CompoundBuilder instance.
+func (cb *CompoundBuilder) Fetch(offset, fetch int) *CompoundBuilder {
+	cb.fetchStatement.Offset = offset
+	cb.fetchStatement.Fetch = fetch
+
+	return cb
+}
+
+// FetchParam sets the FETCH clause with named parameters, bound when the statement is executed.
+//
+// Parameters:
+// - offset Param: The parameter of the number of rows to skip, or an empty Param for no offset.
+// - fetch Param: The parameter of the number of rows to fetch.
+//
+// Returns:
+// - *CompoundBuilder: The CompoundBuilder instance with updated FETCH clause.
+func (cb *CompoundBuilder) FetchParam(offset, fetch Param) *CompoundBuilder {
+	cb.fetchStatement.OffsetParam = offset
+	cb.fetchStatement.FetchParam = fetch
+
+	return cb
+}
+
+// AS sets an alias for the compound query when it is used as a subquery.
+//
+// Parameters:
+//   - alias (string): The alias to be used.
+//
+// Returns:
+//   - *CompoundBuilder: The current CompoundBuilder instance.
+//
+// Examples:
+//
+//	SELECT u.* FROM (SELECT id FROM customers UNION SELECT id FROM suppliers) AS u
+func (cb *CompoundBuilder) AS(alias string) *CompoundBuilder {
+	cb.alias = alias
+
+	return cb
+}
+
+// SetDialect sets the dialect used to generate the compound query instead of the default dialect.
+// The combined queries are generated with the same dialect.
+// Sql returns ErrNotSupported for one with a different dialect of its own.
+//
+// Parameters:
+//   - dialect (Dialect): The database dialect, e.g. new(SQLiteDialect).
+//
+// Returns:
+//   - *CompoundBuilder: The current CompoundBuilder instance.
+func (cb *CompoundBuilder) SetDialect(dialect Dialect) *CompoundBuilder {
+	cb.dialect = dialect
+
+	return cb
+}
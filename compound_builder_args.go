@@ -0,0 +1,172 @@
+package fluentsql
+
+import "strings"
+
+// Sql constructs the compound SQL query string and associated arguments.
+//
+// Returns:
+//   - string: The complete SQL query as a string.
+//   - []any: A slice of arguments shared by every combined query.
+//   - error: Any error encountered during the query construction.
+func (cb *CompoundBuilder) Sql() (string, []any, error) {
+	var args []any
+
+	return cb.StringArgs(args)
+}
+
+// Interpolate generates the compound query of Sql with its arguments inlined as literals escaped for the dialect,
+// e.g. for logging or debugging. Execute the statement of Sql with its arguments instead.
+//
+// Returns:
+//   - string: The compound query without placeholders.
+//   - error: The error of Sql, or an error when an argument cannot be written as a literal.
+func (cb *CompoundBuilder) Interpolate() (string, error) {
+	sql, args, err := cb.Sql()
+	if err != nil {
+		return "", err
+	}
+
+	return interpolate(cb.dialect, sql, args)
+}
+
+// Compile generates the query once, for executions binding its named parameters with Bind.
+//
+// Returns:
+// - *Compiled: The compiled query.
+// - error: The error of Sql.
+func (cb *CompoundBuilder) Compile() (*Compiled, error) {
+	return compile(cb.Sql())
+}
+
+// SqlParams generates the query of Sql with its named parameters bound, see Compiled.Bind.
+//
+// Parameters:
+// - params any: The values of the parameters, a map[string]any or a tagged struct.
+//
+// Returns:
+// - string: The query with placeholders.
+// - []any: The arguments of the query.
+// - error: The error of Sql, or of binding the parameters.
+func (cb *CompoundBuilder) SqlParams(params any) (string, []any, error) {
+	compiled, err := cb.Compile()
+	if err != nil {
+		return "", nil, err
+	}
+
+	args, err := compiled.Bind(params)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return compiled.Sql(), args, nil
+}
+
+// StringArgs constructs the compound SQL query string with placeholders and its associated arguments.
+// One args slice is threaded through every combined query, so placeholders stay continuous.
+//
+// Parameters:
+//   - args ([]any): An initial slice of arguments to be used in the query.
+//
+// Returns:
+//   - string: The complete SQL query as a string with placeholders for arguments.
+//   - []any: A slice containing all arguments for the query.
+//   - error: Any error encountered during query string construction.
+func (cb *CompoundBuilder) StringArgs(args []any) (string, []any, error) {
+	if isStrict(cb.dialect) {
+		if err := cb.checkIdents(); err != nil {
+			return "", args, err
+		}
+	}
+
+	return cb.renderArgs(cb.dialect, args)
+}
+
+// renderArgs generates the SQL of StringArgs with the dialect d, the dialect of the enclosing
+// statement for a subquery. The default dialect is used when d is nil.
+func (cb *CompoundBuilder) renderArgs(d Dialect, args []any) (string, []any, error) {
+	if err := checkDialect(cb.dialect, d); err != nil {
+		return "", args, err
+	}
+
+	d = dialectOr(d)
+
+	var queryParts []string
+	var sqlStr string
+	var err error
+
+	// Process every query with its set operator
+	for i, item := range cb.items {
+		if i > 0 {
+			queryParts = append(queryParts, item.opt(d))
+		}
+
+		sqlStr, args, err = item.renderArgs(d, args)
+		if err != nil {
+			return "", args, err
+		}
+
+		queryParts = append(queryParts, sqlStr)
+	}
+
+	// A compound query has no TOP clause, SQL Server pages its result with OFFSET ... FETCH.
+	paged := cb.limitStatement.render(d) != "" || cb.fetchStatement.String() != ""
+
+	sqlStr, args, err = cb.orderByStatement.renderArgs(d, args)
+	if err != nil {
+		return "", args, err
+	}
+	sqlStr = pagingOrderBy(d, sqlStr, paged)
+	if sqlStr != "" {
+		queryParts = append(queryParts, sqlStr)
+	}
+
+	sqlStr, args = cb.limitStatement.renderArgs(d, args)
+	if sqlStr != "" {
+		queryParts = append(queryParts, sqlStr)
+	}
+
+	sqlStr, args = cb.fetchStatement.renderArgs(d, args)
+	if sqlStr != "" {
+		queryParts = append(queryParts, sqlStr)
+	}
+
+	sqlStr = strings.Join(queryParts, " ")
+
+	if cb.alias != "" {
+		sqlStr = d.SubqueryAlias("("+sqlStr+")", cb.alias)
+	}
+
+	return sqlStr, args, nil
+}
+
+// StringArgs generates the SQL string of the item query and appends its arguments.
+//
+// Parameters:
+//   - args ([]any): The input slice to which the query arguments will be appended.
+//
+// Returns:
+//   - string: The SQL query, wrapped in parentheses when required.
+//   - []any: The updated slice of arguments.
+//   - error: Any error returned by the query.
+func (c *CompoundItem) StringArgs(args []any) (string, []any, error) {
+	return c.renderArgs(nil, args)
+}
+
+// renderArgs generates the SQL of StringArgs with the dialect d, the default dialect when nil.
+func (c *CompoundItem) renderArgs(d Dialect, args []any) (string, []any, error) {
+	var sql string
+	var err error
+
+	switch query := c.Query.(type) {
+	case *QueryBuilder:
+		sql, args, err = query.renderArgs(d, args)
+	case *CompoundBuilder:
+		sql, args, err = query.renderArgs(d, args)
+	}
+
+	if err != nil {
+		return "", args, err
+	}
+
+	return c.wrap(d, sql), args, nil
+}
@@ -0,0 +1,143 @@
+package fluentsql
+
+import (
+	"testing"
+)
+
+// TestCompoundBasic
+func TestCompoundBasic(t *testing.T) {
+	testCases := map[string]*CompoundBuilder{
+		"SELECT first_name, last_name FROM employees UNION SELECT first_name, last_name FROM dependents ORDER BY last_name ASC": CompoundInstance(
+			QueryInstance().Select("first_name", "last_name").From("employees"),
+		).
+			Union(QueryInstance().Select("first_name", "last_name").From("dependents")).
+			OrderBy("last_name", Asc),
+		"SELECT id FROM a UNION ALL SELECT id FROM b INTERSECT SELECT id FROM c EXCEPT SELECT id FROM d": CompoundInstance(
+			QueryInstance().Select("id").From("a"),
+		).
+			UnionAll(QueryInstance().Select("id").From("b")).
+			Intersect(QueryInstance().Select("id").From("c")).
+			Except(QueryInstance().Select("id").From("d")),
+		"SELECT id FROM a INTERSECT ALL SELECT id FROM b EXCEPT ALL SELECT id FROM c LIMIT 10 OFFSET 20": CompoundInstance(
+			QueryInstance().Select("id").From("a"),
+		).
+			IntersectAll(QueryInstance().Select("id").From("b")).
+			ExceptAll(QueryInstance().Select("id").From("c")).
+			Limit(10, 20),
+		"(SELECT id FROM a ORDER BY id DESC LIMIT 1 OFFSET 0) UNION (SELECT id FROM b UNION SELECT id FROM c) OFFSET 0 ROWS FETCH NEXT 5 ROWS ONLY": CompoundInstance(
+			QueryInstance().Select("id").From("a").OrderBy("id", Desc).Limit(1, 0),
+		).
+			Union(CompoundInstance(QueryInstance().Select("id").From("b")).Union(QueryInstance().Select("id").From("c"))).
+			Fetch(0, 5),
+	}
+
+	for expected, query := range testCases {
+		if query.String() != expected {
+			t.Fatalf(`Query %s != %s`, query.String(), expected)
+		}
+	}
+}
+
+// TestCompoundArgs
+func TestCompoundArgs(t *testing.T) {
+	query := CompoundInstance(
+		QueryInstance().Select("id").From("customers").Where("country", Eq, "VN"),
+	).
+		UnionAll(QueryInstance().Select("id").From("suppliers").Where("country", In, []string{"UK", "US"})).
+		OrderBy("id", Asc).
+		Limit(10, 0)
+
+	sql, args, err := query.Sql()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected := "SELECT id FROM customers WHERE country = $1 UNION ALL SELECT id FROM suppliers WHERE country IN ($2, $3) ORDER BY id ASC LIMIT $4 OFFSET $5"
+	if sql != expected {
+		t.Fatalf(`Query %s != %s`, sql, expected)
+	}
+
+	if len(args) != 5 || args[0] != "VN" || args[1] != "UK" || args[2] != "US" || args[3] != 10 || args[4] != 0 {
+		t.Fatalf("Unexpected arguments %v", args)
+	}
+}
+
+// TestCompoundSubquery
+func TestCompoundSubquery(t *testing.T) {
+	ids := func() *CompoundBuilder {
+		return CompoundInstance(QueryInstance().Select("id").From("customers").Where("active", Eq, true)).
+			Union(QueryInstance().Select("id").From("suppliers").Where("active", Eq, true))
+	}
+
+	testCases := map[string]*QueryBuilder{
+		"SELECT u.id FROM (SELECT id FROM customers WHERE active = $1 UNION SELECT id FROM suppliers WHERE active = $2) AS u WHERE u.id > $3": QueryInstance().
+			Select("u.id").
+			From(ids().AS("u")).
+			Where("u.id", Greater, 10),
+		"SELECT name FROM contacts WHERE contact_id IN (SELECT id FROM customers WHERE active = $1 UNION SELECT id FROM suppliers WHERE active = $2)": QueryInstance().
+			Select("name").
+			From("contacts").
+			Where("contact_id", In, ids()),
+		"SELECT name, (SELECT id FROM customers WHERE active = $1 UNION SELECT id FROM suppliers WHERE active = $2) FROM contacts WHERE name = $3": QueryInstance().
+			Select("name", ids()).
+			From("contacts").
+			Where("name", Eq, "John"),
+	}
+
+	for expected, query := range testCases {
+		sql, args, _ := query.Sql()
+
+		if sql != expected {
+			t.Fatalf(`Query %s != %s (%v)`, sql, expected, args)
+		}
+	}
+
+	query := QueryInstance().Select("name").From(ids()).Where("contact_id", In, ids())
+	expected := "SELECT name FROM (SELECT id FROM customers WHERE active = true UNION SELECT id FROM suppliers WHERE active = true) WHERE contact_id IN (SELECT id FROM customers WHERE active = true UNION SELECT id FROM suppliers WHERE active = true)"
+
+	if query.String() != expected {
+		t.Fatalf(`Query %s != %s`, query.String(), expected)
+	}
+}
+
+// TestCompoundRecursiveCTE
+func TestCompoundRecursiveCTE(t *testing.T) {
+	query := QueryInstance().
+		WithRecursive("tree", CompoundInstance(
+			QueryInstance().Select("id", "parent_id").From("categories").Where("id", Eq, 1),
+		).UnionAll(
+			QueryInstance().Select("c.id", "c.parent_id").From("categories", "c").
+				Join(InnerJoin, "tree t", Condition{Field: "c.parent_id", Opt: Eq, Value: ValueField("t.id")}),
+		), "id", "parent_id").
+		Select("id").
+		From("tree").
+		Where("id", NotEq, 5)
+
+	sql, args, _ := query.Sql()
+
+	expected := "WITH RECURSIVE tree (id, parent_id) AS (SELECT id, parent_id FROM categories WHERE id = $1 UNION ALL SELECT c.id, c.parent_id FROM categories c INNER JOIN tree t ON c.parent_id = t.id) SELECT id FROM tree WHERE id <> $2"
+	if sql != expected {
+		t.Fatalf(`Query %s != %s (%v)`, sql, expected, args)
+	}
+}
+
+// TestCompoundSQLite tests the members of a compound query selected from on SQLite
+func TestCompoundSQLite(t *testing.T) {
+	query := CompoundInstance(
+		QueryInstance().Select("id").From("a").OrderBy("id", Desc).Limit(1, 0),
+	).
+		SetDialect(new(SQLiteDialect)).
+		Union(CompoundInstance(QueryInstance().Select("id").From("b")).Union(QueryInstance().Select("id").From("c"))).
+		Union(QueryInstance().Select("id").From("d"))
+
+	sql, args, err := query.Sql()
+
+	expected := "SELECT * FROM (SELECT id FROM a ORDER BY id DESC LIMIT ? OFFSET ?) UNION SELECT * FROM (SELECT id FROM b UNION SELECT id FROM c) UNION SELECT id FROM d"
+	if err != nil || sql != expected {
+		t.Fatalf(`Query %s != %s (%v)`, sql, expected, err)
+	}
+
+	if len(args) != 2 || args[0] != 1 || args[1] != 0 {
+		t.Fatalf("Unexpected arguments %v", args)
+	}
+}
@@ -17,8 +17,13 @@ type Delete struct {
 // Returns:
 //   - A string representing the DELETE SQL query.
 func (u *Delete) String() string {
+	return u.render(nil)
+}
+
+// render generates the SQL of String with the dialect d, the default dialect when nil.
+func (u *Delete) render(d Dialect) string {
 	var sb strings.Builder // sb is a string builder used to efficiently construct the query string
-	sb.WriteString(fmt.Sprintf("DELETE FROM %s", u.Table))
+	sb.WriteString(fmt.Sprintf("DELETE FROM %s", dialectField(d, u.Table)))
 
 	if u.Alias != "" {
 		sb.WriteString(" " + u.Alias)
@@ -15,13 +15,17 @@ import "strings"
 //	[WHERE where_condition]
 //	[ORDER BY ...]
 //	[LIMIT row_count]
+//	[RETURNING output_expression [, ...]]
 //
 // It defines the components of the DELETE query.
 type DeleteBuilder struct {
-	deleteStatement  Delete  // Defines the DELETE clause for specifying the table and optional alias
-	whereStatement   Where   // Stores conditions for the WHERE clause
-	orderByStatement OrderBy // Represents sorting conditions for the ORDER BY clause
-	limitStatement   Limit   // Specifies the LIMIT and OFFSET for the query
+	dialect            Dialect   // Overrides the default dialect when set, subqueries use the dialect of the statement
+	withStatement      With      // Defines the WITH clause placed before the DELETE statement
+	deleteStatement    Delete    // Defines the DELETE clause for specifying the table and optional alias
+	whereStatement     Where     // Stores conditions for the WHERE clause
+	orderByStatement   OrderBy   // Represents sorting conditions for the ORDER BY clause
+	limitStatement     Limit     // Specifies the LIMIT and OFFSET for the query
+	returningStatement Returning // Defines the RETURNING clause for the deleted rows
 }
 
 // DeleteInstance creates a new instance of DeleteBuilder.
@@ -44,19 +48,39 @@ func DeleteInstance() *DeleteBuilder {
 // Returns:
 //   - A string representing the complete DELETE SQL query.
 func (db *DeleteBuilder) String() string {
+	return db.render(db.dialect)
+}
+
+// render generates the SQL of String with the dialect d, the dialect of the enclosing
+// statement for a subquery. The default dialect is used when d is nil.
+func (db *DeleteBuilder) render(d Dialect) string {
+	d = dialectOr(d)
+
 	var queryParts []string
 
+	// Add the WITH clause if present
+	withSql := db.withStatement.render(d)
+	if withSql != "" {
+		queryParts = append(queryParts, withSql)
+	}
+
 	// Add the DELETE statement
-	queryParts = append(queryParts, db.deleteStatement.String())
+	queryParts = append(queryParts, db.deleteStatement.render(d))
+
+	// Add the OUTPUT clause if present
+	outputSql := db.returningStatement.output(d, "deleted")
+	if outputSql != "" {
+		queryParts = append(queryParts, outputSql)
+	}
 
 	// Add the WHERE clause if present
-	whereSql := db.whereStatement.String()
+	whereSql := db.whereStatement.render(d)
 	if whereSql != "" {
 		queryParts = append(queryParts, whereSql)
 	}
 
 	// Add the ORDER BY clause if present
-	orderBySql := db.orderByStatement.String()
+	orderBySql := db.orderByStatement.render(d)
 	if orderBySql != "" {
 		queryParts = append(queryParts, orderBySql)
 	}
@@ -67,21 +91,75 @@ func (db *DeleteBuilder) String() string {
 		queryParts = append(queryParts, limitSql)
 	}
 
+	// Add the RETURNING clause if present
+	returningSql := db.returningStatement.render(d)
+	if returningSql != "" {
+		queryParts = append(queryParts, returningSql)
+	}
+
 	// Combine all parts into a single SQL string
 	sql := strings.Join(queryParts, " ")
 
 	return sql
 }
 
+// With adds a common table expression to the WITH clause placed before the statement.
+//
+// Parameters:
+//   - name (string): The name of the CTE.
+//   - query (any): The body of the CTE, a *QueryBuilder or *CompoundBuilder.
+//   - columns (...string): Optional column names of the CTE.
+//
+// Returns:
+//   - *DeleteBuilder: The current DeleteBuilder instance.
+func (db *DeleteBuilder) With(name string, query any, columns ...string) *DeleteBuilder {
+	db.withStatement.Append(CTE{
+		Name:    name,
+		Columns: columns,
+		Query:   query,
+	})
+
+	return db
+}
+
+// WithRecursive adds a common table expression and marks the WITH clause as RECURSIVE.
+// The keyword is omitted under SQL Server and Oracle, whose CTEs are recursive without it.
+//
+// Parameters:
+//   - name (string): The name of the CTE.
+//   - query (any): The body of the CTE, a *QueryBuilder or *CompoundBuilder.
+//   - columns (...string): Optional column names of the CTE.
+//
+// Returns:
+//   - *DeleteBuilder: The current DeleteBuilder instance.
+func (db *DeleteBuilder) WithRecursive(name string, query any, columns ...string) *DeleteBuilder {
+	db.withStatement.Recursive = true
+
+	return db.With(name, query, columns...)
+}
+
+// WithCTE appends prepared common table expressions to the WITH clause.
+//
+// Parameters:
+//   - ctes (...CTE): The CTEs to be added.
+//
+// Returns:
+//   - *DeleteBuilder: The current DeleteBuilder instance.
+func (db *DeleteBuilder) WithCTE(ctes ...CTE) *DeleteBuilder {
+	db.withStatement.Append(ctes...)
+
+	return db
+}
+
 // Delete specifies the table and an optional alias for the DELETE query.
 //
 // Parameters:
-//   - table (string): The name of the table from which rows will be deleted.
+//   - table (any): The name of the table from which rows will be deleted, a string or an Ident.
 //   - alias (...string): An optional alias for the table.
 //
 // Returns:
 //   - *DeleteBuilder: A pointer to the current instance of DeleteBuilder.
-func (db *DeleteBuilder) Delete(table string, alias ...string) *DeleteBuilder {
+func (db *DeleteBuilder) Delete(table any, alias ...string) *DeleteBuilder {
 	db.deleteStatement.Table = table
 
 	if len(alias) > 0 {
@@ -163,3 +241,58 @@ func (db *DeleteBuilder) WhereCondition(conditions ...Condition) *DeleteBuilder
 
 	return db
 }
+
+// OrderBy adds a field to the ORDER BY clause, which sets the order of the deleted rows (MySQL).
+//
+// Parameters:
+//   - field (any): The field to sort by, a string, an Ident or an expression.
+//   - dir (OrderByDir): The direction of sorting (ASC or DESC).
+//
+// Returns:
+//   - *DeleteBuilder: A pointer to the current instance of DeleteBuilder.
+func (db *DeleteBuilder) OrderBy(field any, dir OrderByDir) *DeleteBuilder {
+	db.orderByStatement.Append(field, dir)
+
+	return db
+}
+
+// OrderBySort appends sort items with a NULLS position or a collation to the ORDER BY clause.
+//
+// Parameters:
+//   - items (...SortItem): The sort items, e.g. Sort("created_at", Asc).NullsFirst().
+//
+// Returns:
+//   - *DeleteBuilder: A pointer to the current instance of DeleteBuilder.
+func (db *DeleteBuilder) OrderBySort(items ...SortItem) *DeleteBuilder {
+	db.orderByStatement.Items = append(db.orderByStatement.Items, items...)
+
+	return db
+}
+
+// Returning adds columns or expressions to the RETURNING clause (PostgreSQL, SQLite).
+//
+// Parameters:
+//   - columns (...string): The columns or expressions to be returned, "*" returns every column.
+//
+// Returns:
+//   - *DeleteBuilder: A pointer to the current instance of DeleteBuilder.
+func (db *DeleteBuilder) Returning(columns ...string) *DeleteBuilder {
+	db.returningStatement.Append(columns...)
+
+	return db
+}
+
+// SetDialect sets the dialect used to generate the statement instead of the default dialect.
+// Subqueries and CTEs are generated with the same dialect.
+// Sql returns ErrNotSupported for one with a different dialect of its own.
+//
+// Parameters:
+//   - dialect (Dialect): The database dialect, e.g. new(MySQLDialect).
+//
+// Returns:
+//   - *DeleteBuilder: A pointer to the current instance of DeleteBuilder.
+func (db *DeleteBuilder) SetDialect(dialect Dialect) *DeleteBuilder {
+	db.dialect = dialect
+
+	return db
+}
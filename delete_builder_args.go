@@ -1,7 +1,6 @@
 package fluentsql
 
 import (
-	"fmt"
 	"strings"
 )
 
@@ -17,6 +16,53 @@ func (db *DeleteBuilder) Sql() (string, []any, error) {
 	return db.StringArgs(args)
 }
 
+// Interpolate generates the DELETE statement of Sql with its arguments inlined as literals escaped for the dialect,
+// e.g. for logging or debugging. Execute the statement of Sql with its arguments instead.
+//
+// Returns:
+//   - string: The DELETE statement without placeholders.
+//   - error: The error of Sql, or an error when an argument cannot be written as a literal.
+func (db *DeleteBuilder) Interpolate() (string, error) {
+	sql, args, err := db.Sql()
+	if err != nil {
+		return "", err
+	}
+
+	return interpolate(db.dialect, sql, args)
+}
+
+// Compile generates the statement once, for executions binding its named parameters with Bind.
+//
+// Returns:
+// - *Compiled: The compiled statement.
+// - error: The error of Sql.
+func (db *DeleteBuilder) Compile() (*Compiled, error) {
+	return compile(db.Sql())
+}
+
+// SqlParams generates the statement of Sql with its named parameters bound, see Compiled.Bind.
+//
+// Parameters:
+// - params any: The values of the parameters, a map[string]any or a tagged struct.
+//
+// Returns:
+// - string: The statement with placeholders.
+// - []any: The arguments of the statement.
+// - error: The error of Sql, or of binding the parameters.
+func (db *DeleteBuilder) SqlParams(params any) (string, []any, error) {
+	compiled, err := db.Compile()
+	if err != nil {
+		return "", nil, err
+	}
+
+	args, err := compiled.Bind(params)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return compiled.Sql(), args, nil
+}
+
 // StringArgs constructs and returns the DELETE SQL query string along with its arguments.
 //
 // Parameters:
@@ -27,27 +73,76 @@ func (db *DeleteBuilder) Sql() (string, []any, error) {
 //   - []any: A slice of any type containing the arguments used in the query.
 //   - error: Any error that may occur during the query construction.
 func (db *DeleteBuilder) StringArgs(args []any) (string, []any, error) {
+	if isStrict(db.dialect) {
+		if err := db.checkIdents(); err != nil {
+			return "", args, err
+		}
+	}
+
+	return db.renderArgs(db.dialect, args)
+}
+
+// renderArgs generates the SQL of StringArgs with the dialect d, the dialect of the enclosing
+// statement for a subquery. The default dialect is used when d is nil.
+func (db *DeleteBuilder) renderArgs(d Dialect, args []any) (string, []any, error) {
+	if err := checkDialect(db.dialect, d); err != nil {
+		return "", args, err
+	}
+
+	d = dialectOr(d)
+
 	var queryParts []string // A slice to gather all query parts (e.g., DELETE, WHERE, etc.).
 	var sqlStr string       // Holds the current query string component.
+	var err error
+
+	// Add the WITH clause if present.
+	sqlStr, args, err = db.withStatement.renderArgs(d, args)
+	if err != nil {
+		return "", args, err
+	}
+	if sqlStr != "" {
+		queryParts = append(queryParts, sqlStr)
+	}
 
 	// Add the DELETE statement and arguments.
-	sqlStr, args = db.deleteStatement.StringArgs(args)
+	sqlStr, args = db.deleteStatement.renderArgs(d, args)
 	queryParts = append(queryParts, sqlStr)
 
+	// Add the OUTPUT clause if present.
+	sqlStr = db.returningStatement.output(d, "deleted")
+	if sqlStr != "" {
+		queryParts = append(queryParts, sqlStr)
+	}
+
 	// Add the WHERE clause if present.
-	sqlStr, args = db.whereStatement.StringArgs(args)
+	sqlStr, args, err = db.whereStatement.renderArgs(d, args)
+	if err != nil {
+		return "", args, err
+	}
 	if sqlStr != "" {
 		queryParts = append(queryParts, sqlStr)
 	}
 
 	// Add the ORDER BY clause if present.
-	sqlStr, args = db.orderByStatement.StringArgs(args)
+	sqlStr, args, err = db.orderByStatement.renderArgs(d, args)
+	if err != nil {
+		return "", args, err
+	}
 	if sqlStr != "" {
 		queryParts = append(queryParts, sqlStr)
 	}
 
 	// Add the LIMIT clause if present.
-	sqlStr, args = db.limitStatement.StringArgs(args)
+	sqlStr, args = db.limitStatement.renderArgs(d, args)
+	if sqlStr != "" {
+		queryParts = append(queryParts, sqlStr)
+	}
+
+	// Add the RETURNING clause if present.
+	sqlStr, args, err = db.returningStatement.renderArgs(d, args)
+	if err != nil {
+		return "", args, err
+	}
 	if sqlStr != "" {
 		queryParts = append(queryParts, sqlStr)
 	}
@@ -67,13 +162,10 @@ func (db *DeleteBuilder) StringArgs(args []any) (string, []any, error) {
 //   - string: The DELETE SQL statement including the table and alias (if present).
 //   - []any: The updated slice of query arguments.
 func (u *Delete) StringArgs(args []any) (string, []any) {
-	var sb strings.Builder                                 // A strings.Builder to construct the query string efficiently.
-	sb.WriteString(fmt.Sprintf("DELETE FROM %s", u.Table)) // Add the table to the DELETE statement.
-
-	// Add alias to the DELETE statement if it's not empty.
-	if u.Alias != "" {
-		sb.WriteString(" " + u.Alias)
-	}
+	return u.renderArgs(nil, args)
+}
 
-	return sb.String(), args
+// renderArgs generates the SQL of StringArgs with the dialect d, the default dialect when nil.
+func (u *Delete) renderArgs(d Dialect, args []any) (string, []any) {
+	return u.render(d), args
 }
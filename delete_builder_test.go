@@ -1,6 +1,7 @@
 package fluentsql
 
 import (
+	"errors"
 	"testing"
 )
 
@@ -128,3 +129,57 @@ func TestDeleteWhereCondition(t *testing.T) {
 		}
 	}
 }
+
+// TestDeleteWith tests the WITH clause of DeleteBuilder
+func TestDeleteWith(t *testing.T) {
+	query := DeleteInstance().
+		WithRecursive("tree", QueryInstance().
+			Select("id").
+			From("categories").
+			Where("id", Eq, 7),
+			"id",
+		).
+		Delete("categories").
+		Where("id", In, QueryInstance().Select("id").From("tree"))
+
+	sql, args, _ := query.Sql()
+
+	expected := "WITH RECURSIVE tree (id) AS (SELECT id FROM categories WHERE id = $1) DELETE FROM categories WHERE id IN (SELECT id FROM tree)"
+	if sql != expected {
+		t.Fatalf(`Query %s != %s (%v)`, sql, expected, args)
+	}
+
+	if query.String() != "WITH RECURSIVE tree (id) AS (SELECT id FROM categories WHERE id = 7) DELETE FROM categories WHERE id IN (SELECT id FROM tree)" {
+		t.Fatalf(`Unexpected query %s`, query.String())
+	}
+}
+
+// TestDeleteReturning tests the RETURNING clause of DeleteBuilder
+func TestDeleteReturning(t *testing.T) {
+	originalDialect := defaultDialect
+	defer func() {
+		defaultDialect = originalDialect
+	}()
+
+	query := DeleteInstance().
+		Delete("jobs").
+		Where("status", Eq, "done").
+		Returning("id", "finished_at")
+
+	sql, args, err := query.Sql()
+
+	expected := "DELETE FROM jobs WHERE status = $1 RETURNING id, finished_at"
+	if err != nil || sql != expected {
+		t.Fatalf(`Query %s != %s (%v)`, sql, expected, err)
+	}
+
+	if len(args) != 1 || args[0] != "done" {
+		t.Fatalf("Unexpected arguments %v", args)
+	}
+
+	SetDialect(new(MySQLDialect))
+
+	if _, _, err = query.Sql(); !errors.Is(err, ErrNotSupported) {
+		t.Fatalf("Expected ErrNotSupported, got %v", err)
+	}
+}
@@ -129,7 +129,8 @@ func insertUser(db *sqlx.DB, user User) (int, error) {
 	// Build the INSERT query using fluentsql
 	query := qb.InsertInstance().
 		Insert("users", "username", "email", "created_at", "updated_at").
-		Row(user.Username, user.Email, user.CreatedAt, user.UpdatedAt)
+		Row(user.Username, user.Email, user.CreatedAt, user.UpdatedAt).
+		Returning("id") // Get the inserted ID (PostgreSQL)
 
 	// Get the SQL and arguments
 	sql, args, err := query.StringArgs([]any{})
@@ -137,9 +138,6 @@ func insertUser(db *sqlx.DB, user User) (int, error) {
 		return 0, fmt.Errorf("failed to build insert query: %w", err)
 	}
 
-	// Add RETURNING clause for PostgreSQL to get the inserted ID
-	sql = sql + " RETURNING id"
-
 	// Execute the query
 	var id int
 	err = db.QueryRow(sql, args...).Scan(&id)
@@ -0,0 +1,179 @@
+// Package executor runs the statements produced by the fluentsql builders on a database/sql connection.
+//
+// It is optional: the root package only generates SQL strings and arguments, this package adds the
+// glue around QueryContext / QueryRowContext / ExecContext that every caller would otherwise write.
+//
+// Examples:
+//
+//	rows, err := executor.Query(ctx, db, fluentsql.QueryInstance().Select("id").From("users"))
+//
+//	var id int
+//	err = executor.QueryRow(ctx, tx, fluentsql.InsertInstance().Insert("users", "name").Row("Ann").Returning("id"), &id)
+//
+//	result, err := executor.Exec(ctx, conn, fluentsql.DeleteInstance().Delete("users").Where("id", fluentsql.Eq, 1))
+package executor
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/jivegroup/fluentsql"
+)
+
+// ====================================================================
+// =========================== Interfaces =============================
+// ====================================================================
+
+// Querier is the subset of database/sql used to run statements.
+// It is satisfied by *sql.DB, *sql.Tx and *sql.Conn.
+type Querier interface {
+	// QueryContext executes a query that returns rows.
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+
+	// QueryRowContext executes a query that is expected to return at most one row.
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+
+	// ExecContext executes a statement without returning any rows.
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+// Builder is a statement builder of the fluentsql package.
+// It is satisfied by QueryBuilder, CompoundBuilder, InsertBuilder, UpdateBuilder and DeleteBuilder.
+type Builder interface {
+	// Sql generates the statement with placeholders and its arguments.
+	Sql() (string, []any, error)
+}
+
+var (
+	_ Querier = (*sql.DB)(nil)
+	_ Querier = (*sql.Tx)(nil)
+	_ Querier = (*sql.Conn)(nil)
+
+	_ Builder = (*fluentsql.QueryBuilder)(nil)
+	_ Builder = (*fluentsql.CompoundBuilder)(nil)
+	_ Builder = (*fluentsql.InsertBuilder)(nil)
+	_ Builder = (*fluentsql.UpdateBuilder)(nil)
+	_ Builder = (*fluentsql.DeleteBuilder)(nil)
+)
+
+// ====================================================================
+// ============================= Errors ===============================
+// ====================================================================
+
+// Error wraps an error returned while building or running a statement with the failing SQL.
+// Use errors.Is / errors.As on it to reach the builder or driver error, e.g. sql.ErrNoRows.
+type Error struct {
+	Query string // Query is the SQL statement that failed, with placeholders. It is empty when the build failed.
+	Err   error  // Err is the builder or driver error.
+}
+
+// Error returns the message of the wrapped error followed by the failing SQL, if any.
+// The values of the statement are never part of the message.
+func (e *Error) Error() string {
+	if e.Query == "" {
+		return e.Err.Error()
+	}
+
+	return fmt.Sprintf("%v [%s]", e.Err, e.Query)
+}
+
+// Unwrap returns the builder or driver error.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// build generates the statement of a builder.
+//
+// Parameters:
+//   - builder: The statement builder.
+//
+// Returns:
+//   - string: The SQL statement with placeholders.
+//   - []any: The arguments of the statement.
+//   - error: An *Error wrapping the builder error when the build failed.
+func build(builder Builder) (string, []any, error) {
+	query, args, err := builder.Sql()
+	if err != nil {
+		return "", nil, &Error{Query: query, Err: err}
+	}
+
+	return query, args, nil
+}
+
+// ====================================================================
+// ============================ Execution =============================
+// ====================================================================
+
+// Query runs a statement that returns rows, e.g. a SELECT or a statement with a RETURNING clause.
+// The caller must close the returned rows.
+//
+// Parameters:
+//   - ctx: The context of the query.
+//   - querier: The connection, transaction or pool to run the statement on.
+//   - builder: The statement builder.
+//
+// Returns:
+//   - *sql.Rows: The result rows.
+//   - error: An *Error wrapping the builder or driver error.
+func Query(ctx context.Context, querier Querier, builder Builder) (*sql.Rows, error) {
+	query, args, err := build(builder)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := querier.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, &Error{Query: query, Err: err}
+	}
+
+	return rows, nil
+}
+
+// QueryRow runs a statement that returns at most one row and scans its columns into dest.
+// It returns an error wrapping sql.ErrNoRows when the statement returns no row.
+//
+// Parameters:
+//   - ctx: The context of the query.
+//   - querier: The connection, transaction or pool to run the statement on.
+//   - builder: The statement builder.
+//   - dest: The destinations of the columns, as for sql.Row.Scan.
+//
+// Returns:
+//   - error: An *Error wrapping the builder, driver or scan error.
+func QueryRow(ctx context.Context, querier Querier, builder Builder, dest ...any) error {
+	query, args, err := build(builder)
+	if err != nil {
+		return err
+	}
+
+	if err = querier.QueryRowContext(ctx, query, args...).Scan(dest...); err != nil {
+		return &Error{Query: query, Err: err}
+	}
+
+	return nil
+}
+
+// Exec runs a statement without returning any rows, e.g. an INSERT, UPDATE or DELETE.
+//
+// Parameters:
+//   - ctx: The context of the statement.
+//   - querier: The connection, transaction or pool to run the statement on.
+//   - builder: The statement builder.
+//
+// Returns:
+//   - sql.Result: The result of the statement (affected rows, last insert id).
+//   - error: An *Error wrapping the builder or driver error.
+func Exec(ctx context.Context, querier Querier, builder Builder) (sql.Result, error) {
+	query, args, err := build(builder)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := querier.ExecContext(ctx, query, args...)
+	if err != nil {
+		return nil, &Error{Query: query, Err: err}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,259 @@
+package executor
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/jivegroup/fluentsql"
+)
+
+// ====================================================================
+//                   Fake driver
+// ====================================================================
+
+// fakeConnector is a database/sql connector returning fixed rows and recording the statements.
+type fakeConnector struct {
+	columns []string         // columns returned by every query
+	rows    [][]driver.Value // rows returned by every query
+	err     error            // err returned by every statement when set
+	queries []string         // queries records the executed statements
+	args    [][]any          // args records the arguments of the executed statements
+}
+
+func (c *fakeConnector) Connect(context.Context) (driver.Conn, error) {
+	return &fakeConn{connector: c}, nil
+}
+
+func (c *fakeConnector) Driver() driver.Driver {
+	return nil
+}
+
+// open returns a *sql.DB backed by the connector.
+func (c *fakeConnector) open(t *testing.T) *sql.DB {
+	db := sql.OpenDB(c)
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+
+	return db
+}
+
+type fakeConn struct {
+	connector *fakeConnector
+}
+
+func (c *fakeConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("prepare not supported")
+}
+
+func (c *fakeConn) Close() error {
+	return nil
+}
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("transactions not supported")
+}
+
+func (c *fakeConn) record(query string, args []driver.NamedValue) error {
+	values := make([]any, len(args))
+	for i, arg := range args {
+		values[i] = arg.Value
+	}
+
+	c.connector.queries = append(c.connector.queries, query)
+	c.connector.args = append(c.connector.args, values)
+
+	return c.connector.err
+}
+
+func (c *fakeConn) QueryContext(_ context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	if err := c.record(query, args); err != nil {
+		return nil, err
+	}
+
+	return &fakeRows{columns: c.connector.columns, rows: c.connector.rows}, nil
+}
+
+func (c *fakeConn) ExecContext(_ context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	if err := c.record(query, args); err != nil {
+		return nil, err
+	}
+
+	return driver.RowsAffected(len(c.connector.rows)), nil
+}
+
+type fakeRows struct {
+	columns []string
+	rows    [][]driver.Value
+	next    int
+}
+
+func (r *fakeRows) Columns() []string {
+	return r.columns
+}
+
+func (r *fakeRows) Close() error {
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.next >= len(r.rows) {
+		return io.EOF
+	}
+
+	copy(dest, r.rows[r.next])
+	r.next++
+
+	return nil
+}
+
+// ====================================================================
+//                   Tests
+// ====================================================================
+
+// TestQuery
+func TestQuery(t *testing.T) {
+	connector := &fakeConnector{
+		columns: []string{"id", "name"},
+		rows:    [][]driver.Value{{int64(1), "Ann"}, {int64(2), "Bob"}},
+	}
+	db := connector.open(t)
+
+	rows, err := Query(context.Background(), db, fluentsql.QueryInstance().
+		Select("id", "name").
+		From("users").
+		Where("active", fluentsql.Eq, true))
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	defer rows.Close()
+
+	var names []string
+	for rows.Next() {
+		var id int
+		var name string
+
+		if err = rows.Scan(&id, &name); err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+
+		names = append(names, name)
+	}
+
+	if len(names) != 2 || names[0] != "Ann" || names[1] != "Bob" {
+		t.Fatalf("Unexpected rows %v", names)
+	}
+
+	expected := "SELECT id, name FROM users WHERE active = $1"
+	if connector.queries[0] != expected {
+		t.Fatalf(`Query %s != %s`, connector.queries[0], expected)
+	}
+
+	if len(connector.args[0]) != 1 || connector.args[0][0] != true {
+		t.Fatalf("Unexpected arguments %v", connector.args[0])
+	}
+}
+
+// TestQueryRow
+func TestQueryRow(t *testing.T) {
+	connector := &fakeConnector{
+		columns: []string{"id"},
+		rows:    [][]driver.Value{{int64(7)}},
+	}
+	db := connector.open(t)
+
+	var id int
+	err := QueryRow(context.Background(), db, fluentsql.InsertInstance().
+		Insert("users", "name").
+		Row("Ann").
+		Returning("id"), &id)
+	if err != nil || id != 7 {
+		t.Fatalf("Unexpected result %d (%v)", id, err)
+	}
+
+	// No row is reported as sql.ErrNoRows wrapped with the query
+	connector.rows = nil
+
+	err = QueryRow(context.Background(), db, fluentsql.QueryInstance().Select("id").From("users"), &id)
+	if !errors.Is(err, sql.ErrNoRows) {
+		t.Fatalf("Expected sql.ErrNoRows, got %v", err)
+	}
+
+	var execErr *Error
+	if !errors.As(err, &execErr) || execErr.Query != "SELECT id FROM users" {
+		t.Fatalf("Expected *Error with the query, got %v", err)
+	}
+}
+
+// TestExec
+func TestExec(t *testing.T) {
+	connector := &fakeConnector{rows: [][]driver.Value{{}, {}}}
+	db := connector.open(t)
+
+	result, err := Exec(context.Background(), db, fluentsql.UpdateInstance().
+		Update("users").
+		Set("active", false).
+		Where("id", fluentsql.In, []int{1, 2}))
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	if affected, _ := result.RowsAffected(); affected != 2 {
+		t.Fatalf("Expected 2 affected rows, got %d", affected)
+	}
+
+	_, err = Exec(context.Background(), db, fluentsql.DeleteInstance().Delete("users").Where("id", fluentsql.Eq, 1))
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	if connector.queries[1] != "DELETE FROM users WHERE id = $1" {
+		t.Fatalf("Unexpected query %s", connector.queries[1])
+	}
+}
+
+// TestErrors
+func TestErrors(t *testing.T) {
+	errDriver := errors.New("relation \"users\" does not exist")
+	connector := &fakeConnector{err: errDriver}
+	db := connector.open(t)
+
+	// Driver errors are wrapped with the query
+	_, err := Exec(context.Background(), db, fluentsql.DeleteInstance().Delete("users"))
+	if !errors.Is(err, errDriver) {
+		t.Fatalf("Expected driver error, got %v", err)
+	}
+
+	if !strings.Contains(err.Error(), "DELETE FROM users") {
+		t.Fatalf("Expected the query in the error, got %v", err)
+	}
+
+	// Builder errors are wrapped without the statement and its values, and the driver is not called
+	originalDialect := fluentsql.DefaultDialect()
+	defer fluentsql.SetDialect(originalDialect)
+
+	fluentsql.SetDialect(new(fluentsql.MySQLDialect))
+
+	_, err = Query(context.Background(), db, fluentsql.DeleteInstance().Delete("users").Returning("id"))
+	if !errors.Is(err, fluentsql.ErrNotSupported) {
+		t.Fatalf("Expected ErrNotSupported, got %v", err)
+	}
+
+	if len(connector.queries) != 1 {
+		t.Fatalf("Unexpected queries %v", connector.queries)
+	}
+
+	_, err = Exec(context.Background(), db, fluentsql.UpdateInstance().
+		Update("users").
+		Set("password", "secret").
+		Where("id", fluentsql.Eq, 1).
+		Returning("id"))
+	if !errors.Is(err, fluentsql.ErrNotSupported) || strings.Contains(err.Error(), "secret") {
+		t.Fatalf("Expected ErrNotSupported without the values, got %v", err)
+	}
+}
@@ -0,0 +1,220 @@
+package executor
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/jivegroup/fluentsql/internal/structmap"
+)
+
+// ScanOptions configures the mapping of the result columns onto struct fields.
+type ScanOptions struct {
+	// Strict returns an error when a column has no matching struct field,
+	// instead of silently discarding its value.
+	Strict bool
+}
+
+// ErrUnmappedColumn is returned in strict mode when a result column has no matching struct field.
+var ErrUnmappedColumn = errors.New("fluentsql: unmapped column")
+
+// ScanAll reads every row into a slice of T and closes the rows.
+//
+// T is either a struct (or a pointer to a struct) whose fields are mapped to the columns by their `db` tag,
+// or a scalar type (int, string, time.Time, sql.NullString, ...) when the query returns a single column.
+// Embedded structs are flattened, pointer and sql.Null* fields receive NULL values, and a column matches
+// a field ignoring case and underscores when no tag matches exactly.
+//
+// Parameters:
+//   - rows: The result rows, e.g. returned by Query.
+//   - opts: Optional ScanOptions, e.g. ScanOptions{Strict: true}.
+//
+// Returns:
+//   - []T: The scanned rows. Returns an empty slice if there are no rows.
+//   - error: The mapping, scan or iteration error.
+//
+// Examples:
+//
+//	rows, err := executor.Query(ctx, db, fluentsql.QueryInstance().Select("*").From("users"))
+//	users, err := executor.ScanAll[User](rows)
+func ScanAll[T any](rows *sql.Rows, opts ...ScanOptions) ([]T, error) {
+	defer rows.Close()
+
+	scan, err := scanner[T](rows, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	items := []T{}
+
+	for rows.Next() {
+		item, err := scan()
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, item)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// ScanOne reads the first row into a T and closes the rows. See ScanAll for the mapping rules.
+//
+// Parameters:
+//   - rows: The result rows, e.g. returned by Query.
+//   - opts: Optional ScanOptions, e.g. ScanOptions{Strict: true}.
+//
+// Returns:
+//   - T: The scanned row.
+//   - error: sql.ErrNoRows if there is no row, or the mapping, scan or iteration error.
+func ScanOne[T any](rows *sql.Rows, opts ...ScanOptions) (T, error) {
+	defer rows.Close()
+
+	var item T
+
+	scan, err := scanner[T](rows, opts)
+	if err != nil {
+		return item, err
+	}
+
+	if !rows.Next() {
+		if err = rows.Err(); err != nil {
+			return item, err
+		}
+
+		return item, sql.ErrNoRows
+	}
+
+	if item, err = scan(); err != nil {
+		return item, err
+	}
+
+	return item, rows.Close()
+}
+
+// ScanMap reads every row into a map of column name to value and closes the rows.
+// Values are returned as provided by the driver, []byte values are copied.
+//
+// Parameters:
+//   - rows: The result rows, e.g. returned by Query.
+//
+// Returns:
+//   - []map[string]any: The scanned rows. Returns an empty slice if there are no rows.
+//   - error: The scan or iteration error.
+func ScanMap(rows *sql.Rows) ([]map[string]any, error) {
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+
+	items := []map[string]any{}
+
+	for rows.Next() {
+		values := make([]any, len(columns))
+		dest := make([]any, len(columns))
+
+		for i := range values {
+			dest[i] = &values[i]
+		}
+
+		if err = rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+
+		item := make(map[string]any, len(columns))
+		for i, column := range columns {
+			item[column] = values[i]
+		}
+
+		items = append(items, item)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// scanner prepares the scan of the current row into a T, resolving the column mapping once per result set.
+//
+// Parameters:
+//   - rows: The result rows.
+//   - opts: The scan options.
+//
+// Returns:
+//   - func() (T, error): Scans the current row.
+//   - error: The mapping error.
+func scanner[T any](rows *sql.Rows, opts []ScanOptions) (func() (T, error), error) {
+	var options ScanOptions
+	if len(opts) > 0 {
+		options = opts[0]
+	}
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+
+	typ := reflect.TypeOf((*T)(nil)).Elem()
+
+	// Scalars are scanned directly from the single column.
+	if structmap.IsScalar(typ) {
+		if len(columns) != 1 {
+			return nil, fmt.Errorf("fluentsql: scan of %d columns into %s", len(columns), typ)
+		}
+
+		return func() (T, error) {
+			var item T
+			err := rows.Scan(&item)
+
+			return item, err
+		}, nil
+	}
+
+	meta := structmap.Of(typ)
+
+	// Resolve the field of every column, nil for the discarded columns.
+	fields := make([]*structmap.Field, len(columns))
+	for i, column := range columns {
+		field, ok := meta.Lookup(column)
+		if !ok && options.Strict {
+			return nil, fmt.Errorf("%w: %s in %s", ErrUnmappedColumn, column, meta.Type)
+		}
+
+		fields[i] = field
+	}
+
+	return func() (T, error) {
+		var item T
+
+		// The struct the fields are set on, allocated when T is a pointer.
+		target := reflect.ValueOf(&item).Elem()
+		for target.Kind() == reflect.Pointer {
+			target.Set(reflect.New(target.Type().Elem()))
+			target = target.Elem()
+		}
+
+		dest := make([]any, len(columns))
+		for i, field := range fields {
+			if field == nil {
+				dest[i] = new(any)
+				continue
+			}
+
+			dest[i] = field.Target(target).Addr().Interface()
+		}
+
+		err := rows.Scan(dest...)
+
+		return item, err
+	}, nil
+}
@@ -0,0 +1,146 @@
+package executor
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jivegroup/fluentsql"
+)
+
+type Timestamps struct {
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+type user struct {
+	Timestamps
+	ID       int            `db:"id"`
+	Username string         `db:"username"`
+	Email    sql.NullString `db:"email"`
+	Bio      *string        `db:"bio"`
+}
+
+// query runs a SELECT on the fake connector.
+func query(t *testing.T, connector *fakeConnector) *sql.Rows {
+	rows, err := Query(context.Background(), connector.open(t), fluentsql.QueryInstance().Select("*").From("users"))
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	return rows
+}
+
+// TestScanAll
+func TestScanAll(t *testing.T) {
+	created := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	connector := &fakeConnector{
+		columns: []string{"id", "UserName", "email", "bio", "created_at", "extra"},
+		rows: [][]driver.Value{
+			{int64(1), "ann", "ann@example.com", "Hello", created, "x"},
+			{int64(2), "bob", nil, nil, created, "y"},
+		},
+	}
+
+	users, err := ScanAll[user](query(t, connector))
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	if len(users) != 2 {
+		t.Fatalf("Expected 2 users, got %d", len(users))
+	}
+
+	ann, bob := users[0], users[1]
+
+	if ann.ID != 1 || ann.Username != "ann" || ann.Email.String != "ann@example.com" || *ann.Bio != "Hello" {
+		t.Fatalf("Unexpected user %+v", ann)
+	}
+
+	if !ann.CreatedAt.Equal(created) {
+		t.Fatalf("Unexpected embedded field %v", ann.CreatedAt)
+	}
+
+	if bob.Email.Valid || bob.Bio != nil {
+		t.Fatalf("Expected NULL values, got %+v", bob)
+	}
+
+	// Pointers to structs
+	pointers, err := ScanAll[*user](query(t, connector))
+	if err != nil || len(pointers) != 2 || pointers[1].Username != "bob" {
+		t.Fatalf("Unexpected users %v (%v)", pointers, err)
+	}
+
+	// Strict mode rejects the unmapped column
+	_, err = ScanAll[user](query(t, connector), ScanOptions{Strict: true})
+	if !errors.Is(err, ErrUnmappedColumn) {
+		t.Fatalf("Expected ErrUnmappedColumn, got %v", err)
+	}
+
+	// No rows
+	connector.rows = nil
+
+	users, err = ScanAll[user](query(t, connector))
+	if err != nil || users == nil || len(users) != 0 {
+		t.Fatalf("Expected an empty slice, got %v (%v)", users, err)
+	}
+}
+
+// TestScanAllScalar
+func TestScanAllScalar(t *testing.T) {
+	connector := &fakeConnector{
+		columns: []string{"id"},
+		rows:    [][]driver.Value{{int64(3)}, {int64(5)}},
+	}
+
+	ids, err := ScanAll[int](query(t, connector))
+	if err != nil || len(ids) != 2 || ids[0] != 3 || ids[1] != 5 {
+		t.Fatalf("Unexpected ids %v (%v)", ids, err)
+	}
+
+	connector.columns = []string{"id", "name"}
+	connector.rows = [][]driver.Value{{int64(3), "ann"}}
+
+	if _, err = ScanAll[int](query(t, connector)); err == nil {
+		t.Fatal("Expected an error for several columns")
+	}
+}
+
+// TestScanOne
+func TestScanOne(t *testing.T) {
+	connector := &fakeConnector{
+		columns: []string{"id", "username"},
+		rows:    [][]driver.Value{{int64(1), "ann"}, {int64(2), "bob"}},
+	}
+
+	item, err := ScanOne[user](query(t, connector))
+	if err != nil || item.ID != 1 || item.Username != "ann" {
+		t.Fatalf("Unexpected user %+v (%v)", item, err)
+	}
+
+	connector.rows = nil
+
+	if _, err = ScanOne[user](query(t, connector)); !errors.Is(err, sql.ErrNoRows) {
+		t.Fatalf("Expected sql.ErrNoRows, got %v", err)
+	}
+}
+
+// TestScanMap
+func TestScanMap(t *testing.T) {
+	connector := &fakeConnector{
+		columns: []string{"id", "username", "email"},
+		rows:    [][]driver.Value{{int64(1), "ann", nil}},
+	}
+
+	items, err := ScanMap(query(t, connector))
+	if err != nil || len(items) != 1 {
+		t.Fatalf("Unexpected rows %v (%v)", items, err)
+	}
+
+	if items[0]["id"] != int64(1) || items[0]["username"] != "ann" || items[0]["email"] != nil {
+		t.Fatalf("Unexpected row %v", items[0])
+	}
+}
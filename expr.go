@@ -0,0 +1,636 @@
+package fluentsql
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Expr represents a SQL expression: a function call, an arithmetic operation, a cast, a literal or a parameter.
+// Expressions can be used as condition fields and values, select columns, ORDER BY fields and SET values,
+// and bind their parameters through StringArgs like the other clauses.
+//
+// The operands of the expressions are written as follows:
+//   - Expr: The expression, e.g. Arg("Ann") for a string value.
+//   - string: A column name, written as is.
+//   - Ident: A column name quoted by the dialect.
+//   - ValueField: A column or an expression, written as is.
+//   - *QueryBuilder: A subquery in parentheses.
+//   - nil: NULL.
+//   - Any other value: A parameter, e.g. 2 is bound as $1 on PostgreSQL.
+type Expr interface {
+	// String generates the SQL of the expression with the values inlined, for the default dialect.
+	String() string
+
+	// StringArgs generates the SQL of the expression and appends its parameters to the arguments slice.
+	StringArgs(args []any) (string, []any)
+
+	render(d Dialect) string
+	renderArgs(d Dialect, args []any) (string, []any, error)
+}
+
+// castTypePattern matches the type names accepted by CAST in strict mode, e.g. "int", "varchar(255)" or
+// "double precision".
+var castTypePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_ ]*(\(\d+(\s*,\s*\d+)?\))?$`)
+
+// operatorPattern matches the operators of BinaryExpr accepted in strict mode, e.g. "+" or "||".
+var operatorPattern = regexp.MustCompile(`^[-+*/%|&^<>=!~#@]+$`)
+
+// operand generates the SQL of an operand of an expression with the values inlined.
+//
+// Parameters:
+//   - d: The dialect of the builder, the default dialect when nil.
+//   - value: The operand.
+//
+// Returns:
+//   - string: The SQL of the operand.
+func operand(d Dialect, value any) string {
+	switch v := value.(type) {
+	case FieldYear:
+		return v.render(d)
+	case *QueryBuilder:
+		return "(" + v.render(d) + ")"
+	case Expr:
+		return v.render(d)
+	case string:
+		return v
+	case IValueField:
+		return v.Value()
+	}
+
+	return inline(d, value)
+}
+
+// operandArgs generates the SQL of an operand of an expression and appends its parameters to the arguments slice.
+//
+// Parameters:
+//   - d: The dialect of the builder, the default dialect when nil.
+//   - value: The operand.
+//   - args []any: A slice of arguments.
+//
+// Returns:
+//   - string: The SQL of the operand.
+//   - []any: The updated slice of arguments.
+//   - error: The error of a nested query or expression.
+func operandArgs(d Dialect, value any, args []any) (string, []any, error) {
+	switch v := value.(type) {
+	case FieldYear:
+		return v.render(d), args, nil
+	case *QueryBuilder:
+		sql, args, err := v.renderArgs(d, args)
+		if err != nil {
+			return "", args, err
+		}
+
+		return "(" + sql + ")", args, nil
+	case Expr:
+		return v.renderArgs(d, args)
+	case string:
+		return v, args, nil
+	case IValueField:
+		return v.Value(), args, nil
+	case nil:
+		return "NULL", args, nil
+	}
+
+	args = append(args, value)
+
+	return p(d, args), args, nil
+}
+
+// checkOperand validates the column names given as plain strings in an operand, the function and aggregate
+// names and the cast types of the expressions.
+//
+// Returns:
+//   - error: ErrUnsafeIdent for the first invalid name.
+func checkOperand(value any) error {
+	switch v := value.(type) {
+	case string:
+		return checkIdents(v)
+	case *FuncExpr:
+		if err := checkIdents(v.Name); err != nil {
+			return err
+		}
+
+		for _, arg := range v.Args {
+			if err := checkOperand(arg); err != nil {
+				return err
+			}
+		}
+	case *BinaryExpr:
+		if !operatorPattern.MatchString(v.Op) {
+			return fmt.Errorf("%w: %q", ErrUnsafeIdent, v.Op)
+		}
+
+		if err := checkOperand(v.Left); err != nil {
+			return err
+		}
+
+		return checkOperand(v.Right)
+	case *CastExpr:
+		if !castTypePattern.MatchString(v.Type) {
+			return fmt.Errorf("%w: %q", ErrUnsafeIdent, v.Type)
+		}
+
+		return checkOperand(v.Value)
+	case *AliasExpr:
+		if err := checkIdents(v.Alias); err != nil {
+			return err
+		}
+
+		return checkOperand(v.Value)
+	case *Aggregate:
+		if err := checkIdents(v.Function); err != nil {
+			return err
+		}
+
+		if v.Field != "*" {
+			if err := checkOperand(v.Field); err != nil {
+				return err
+			}
+		}
+
+		if err := checkConditions(v.Conditions); err != nil {
+			return err
+		}
+
+		return checkOrderBy(v.Order)
+	case *Case:
+		if err := checkOperand(v.Exp); err != nil {
+			return err
+		}
+
+		for _, whenClause := range v.WhenClauses {
+			if conditions, ok := whenClause.conditions(); ok {
+				if err := checkConditions(conditions); err != nil {
+					return err
+				}
+			}
+		}
+	case *QueryBuilder:
+		return v.checkIdents()
+	}
+
+	return nil
+}
+
+// ====================================================================
+// ============================ Functions =============================
+// ====================================================================
+
+// FuncExpr represents a function call, e.g. LOWER(email) or COALESCE(nickname, $1).
+type FuncExpr struct {
+	Name string // Name is the name of the function.
+	Args []any  // Args holds the operands passed to the function.
+}
+
+// Func creates a function call expression.
+//
+// Example:
+//
+//	Func("LOWER", "email") // LOWER(email)
+//	Func("ROUND", Mul("price", 1.2), 2) // ROUND(price * $1, $2)
+//
+// Parameters:
+//   - name: The name of the function.
+//   - args: The operands passed to the function.
+//
+// Returns:
+//   - *FuncExpr: A pointer to a new FuncExpr instance.
+func Func(name string, args ...any) *FuncExpr {
+	return &FuncExpr{
+		Name: name,
+		Args: args,
+	}
+}
+
+// Coalesce creates a COALESCE(value, ...) expression, returning the first operand that is not NULL.
+func Coalesce(values ...any) *FuncExpr {
+	return Func("COALESCE", values...)
+}
+
+// NullIf creates a NULLIF(value, other) expression, returning NULL when both operands are equal.
+func NullIf(value, other any) *FuncExpr {
+	return Func("NULLIF", value, other)
+}
+
+// String generates the SQL of the function call for the default dialect.
+func (e *FuncExpr) String() string {
+	return e.render(nil)
+}
+
+// render generates the SQL of String with the dialect d, the default dialect when nil.
+func (e *FuncExpr) render(d Dialect) string {
+	var args []string
+	for _, arg := range e.Args {
+		args = append(args, operand(d, arg))
+	}
+
+	return fmt.Sprintf("%s(%s)", e.Name, strings.Join(args, ", "))
+}
+
+// StringArgs generates the SQL of the function call and appends its parameters to the arguments slice.
+func (e *FuncExpr) StringArgs(args []any) (string, []any) {
+	sql, args, _ := e.renderArgs(nil, args)
+
+	return sql, args
+}
+
+// renderArgs generates the SQL of StringArgs with the dialect d, the default dialect when nil.
+func (e *FuncExpr) renderArgs(d Dialect, args []any) (string, []any, error) {
+	var items []string
+	for _, arg := range e.Args {
+		var sql string
+		var err error
+
+		sql, args, err = operandArgs(d, arg, args)
+		if err != nil {
+			return "", args, err
+		}
+
+		items = append(items, sql)
+	}
+
+	return fmt.Sprintf("%s(%s)", e.Name, strings.Join(items, ", ")), args, nil
+}
+
+// ====================================================================
+// ============================ Operators =============================
+// ====================================================================
+
+// BinaryExpr represents an operation between two operands, e.g. price * qty.
+// A BinaryExpr operand of another BinaryExpr is enclosed in parentheses, so the tree sets the precedence.
+type BinaryExpr struct {
+	Left  any    // Left is the left operand.
+	Op    string // Op is the operator, e.g. "+" or "||".
+	Right any    // Right is the right operand.
+}
+
+// Add creates a left + right expression.
+func Add(left, right any) *BinaryExpr {
+	return &BinaryExpr{Left: left, Op: "+", Right: right}
+}
+
+// Sub creates a left - right expression.
+func Sub(left, right any) *BinaryExpr {
+	return &BinaryExpr{Left: left, Op: "-", Right: right}
+}
+
+// Mul creates a left * right expression.
+func Mul(left, right any) *BinaryExpr {
+	return &BinaryExpr{Left: left, Op: "*", Right: right}
+}
+
+// Div creates a left / right expression.
+func Div(left, right any) *BinaryExpr {
+	return &BinaryExpr{Left: left, Op: "/", Right: right}
+}
+
+// Mod creates a left % right expression.
+func Mod(left, right any) *BinaryExpr {
+	return &BinaryExpr{Left: left, Op: "%", Right: right}
+}
+
+// String generates the SQL of the operation for the default dialect.
+func (e *BinaryExpr) String() string {
+	return e.render(nil)
+}
+
+// render generates the SQL of String with the dialect d, the default dialect when nil.
+func (e *BinaryExpr) render(d Dialect) string {
+	return fmt.Sprintf("%s %s %s", nested(e.Left, operand(d, e.Left)), e.Op, nested(e.Right, operand(d, e.Right)))
+}
+
+// StringArgs generates the SQL of the operation and appends its parameters to the arguments slice.
+func (e *BinaryExpr) StringArgs(args []any) (string, []any) {
+	sql, args, _ := e.renderArgs(nil, args)
+
+	return sql, args
+}
+
+// renderArgs generates the SQL of StringArgs with the dialect d, the default dialect when nil.
+func (e *BinaryExpr) renderArgs(d Dialect, args []any) (string, []any, error) {
+	left, args, err := operandArgs(d, e.Left, args)
+	if err != nil {
+		return "", args, err
+	}
+
+	right, args, err := operandArgs(d, e.Right, args)
+	if err != nil {
+		return "", args, err
+	}
+
+	return fmt.Sprintf("%s %s %s", nested(e.Left, left), e.Op, nested(e.Right, right)), args, nil
+}
+
+// nested encloses the SQL of an operand in parentheses when the operand is a BinaryExpr.
+func nested(value any, sql string) string {
+	if _, ok := value.(*BinaryExpr); ok {
+		return "(" + sql + ")"
+	}
+
+	return sql
+}
+
+// ====================================================================
+// ============================== Casts ===============================
+// ====================================================================
+
+// CastExpr represents a CAST(value AS type) expression.
+type CastExpr struct {
+	Value any    // Value is the operand to convert.
+	Type  string // Type is the SQL type, e.g. "int" or "varchar(255)".
+}
+
+// Cast creates a CAST(value AS type) expression.
+func Cast(value any, typ string) *CastExpr {
+	return &CastExpr{Value: value, Type: typ}
+}
+
+// String generates the SQL of the cast for the default dialect.
+func (e *CastExpr) String() string {
+	return e.render(nil)
+}
+
+// render generates the SQL of String with the dialect d, the default dialect when nil.
+func (e *CastExpr) render(d Dialect) string {
+	return fmt.Sprintf("CAST(%s AS %s)", operand(d, e.Value), e.Type)
+}
+
+// StringArgs generates the SQL of the cast and appends its parameters to the arguments slice.
+func (e *CastExpr) StringArgs(args []any) (string, []any) {
+	sql, args, _ := e.renderArgs(nil, args)
+
+	return sql, args
+}
+
+// renderArgs generates the SQL of StringArgs with the dialect d, the default dialect when nil.
+func (e *CastExpr) renderArgs(d Dialect, args []any) (string, []any, error) {
+	value, args, err := operandArgs(d, e.Value, args)
+	if err != nil {
+		return "", args, err
+	}
+
+	return fmt.Sprintf("CAST(%s AS %s)", value, e.Type), args, nil
+}
+
+// ====================================================================
+// ======================= Literals and parameters ====================
+// ====================================================================
+
+// LitExpr represents a value written as a literal escaped for the dialect, even by StringArgs.
+type LitExpr struct {
+	Value any
+}
+
+// Lit creates a literal expression, e.g. Lit("O'Brien") is written as 'O”Brien'.
+// Use Arg to bind a value as a parameter instead.
+func Lit(value any) *LitExpr {
+	return &LitExpr{Value: value}
+}
+
+// String generates the literal for the default dialect.
+func (e *LitExpr) String() string {
+	return e.render(nil)
+}
+
+// render generates the SQL of String with the dialect d, the default dialect when nil.
+func (e *LitExpr) render(d Dialect) string {
+	return inline(d, e.Value)
+}
+
+// StringArgs generates the literal, the arguments slice is unchanged.
+func (e *LitExpr) StringArgs(args []any) (string, []any) {
+	sql, args, _ := e.renderArgs(nil, args)
+
+	return sql, args
+}
+
+// renderArgs generates the SQL of StringArgs with the dialect d, the default dialect when nil.
+func (e *LitExpr) renderArgs(d Dialect, args []any) (string, []any, error) {
+	return e.render(d), args, nil
+}
+
+// ArgExpr represents a value bound as a parameter. String inlines the value as a literal.
+type ArgExpr struct {
+	Value any
+}
+
+// Arg creates a parameter expression, e.g. for a string operand which would be a column name otherwise:
+// Coalesce("nickname", Arg("anonymous")).
+func Arg(value any) *ArgExpr {
+	return &ArgExpr{Value: value}
+}
+
+// String generates the literal of the value for the default dialect.
+func (e *ArgExpr) String() string {
+	return e.render(nil)
+}
+
+// render generates the SQL of String with the dialect d, the default dialect when nil.
+func (e *ArgExpr) render(d Dialect) string {
+	return inline(d, e.Value)
+}
+
+// StringArgs generates the placeholder of the value and appends the value to the arguments slice.
+func (e *ArgExpr) StringArgs(args []any) (string, []any) {
+	sql, args, _ := e.renderArgs(nil, args)
+
+	return sql, args
+}
+
+// renderArgs generates the SQL of StringArgs with the dialect d, the default dialect when nil.
+func (e *ArgExpr) renderArgs(d Dialect, args []any) (string, []any, error) {
+	args = append(args, e.Value)
+
+	return p(d, args), args, nil
+}
+
+// ====================================================================
+// ============================== Aliases =============================
+// ====================================================================
+
+// AliasExpr represents an expression followed by an alias, e.g. a select column.
+type AliasExpr struct {
+	Value any    // Value is the aliased operand.
+	Alias string // Alias is the name of the column.
+}
+
+// Alias creates a value AS alias expression.
+//
+// Example:
+//
+//	Select(Alias(Mul("price", "qty"), "total")) // SELECT price * qty AS total
+func Alias(value any, alias string) *AliasExpr {
+	return &AliasExpr{Value: value, Alias: alias}
+}
+
+// String generates the SQL of the aliased expression for the default dialect.
+func (e *AliasExpr) String() string {
+	return e.render(nil)
+}
+
+// render generates the SQL of String with the dialect d, the default dialect when nil.
+func (e *AliasExpr) render(d Dialect) string {
+	return fmt.Sprintf("%s AS %s", operand(d, e.Value), e.Alias)
+}
+
+// StringArgs generates the SQL of the aliased expression and appends its parameters to the arguments slice.
+func (e *AliasExpr) StringArgs(args []any) (string, []any) {
+	sql, args, _ := e.renderArgs(nil, args)
+
+	return sql, args
+}
+
+// renderArgs generates the SQL of StringArgs with the dialect d, the default dialect when nil.
+func (e *AliasExpr) renderArgs(d Dialect, args []any) (string, []any, error) {
+	value, args, err := operandArgs(d, e.Value, args)
+	if err != nil {
+		return "", args, err
+	}
+
+	return fmt.Sprintf("%s AS %s", value, e.Alias), args, nil
+}
+
+// ====================================================================
+// =========================== Raw fragments ==========================
+// ====================================================================
+
+// RawExpr represents a SQL fragment written as is, with its own placeholders.
+type RawExpr struct {
+	SQL  string // SQL is the fragment, with ? or $1, $2, ... placeholders.
+	Args []any  // Args holds the values of the placeholders.
+}
+
+// Raw creates a SQL fragment with its own placeholders, written as is. A ? placeholder takes the next
+// argument and a $n placeholder takes the n-th argument, "??" writes a literal "?".
+// StringArgs rewrites the placeholders for the dialect and their position in the statement, and
+// String inlines the arguments as literals. A placeholder without argument is an ErrMissingParam error
+// of StringArgs, String leaves it as written. An argument of type ValueField, or Excluded in an upsert,
+// is written as is instead of being bound. Raw fragments are not validated in strict mode.
+//
+// Raw can be used as a select column, a FROM or JOIN table, a condition field or value,
+// an ORDER BY or GROUP BY field and a SET value. As the field of a condition with a nil value,
+// the fragment is the whole condition.
+//
+// Example:
+//
+//	Where(Raw("created_at > now() - ?::interval", "7 days"), Eq, nil) // WHERE created_at > now() - $1::interval
+//	Where("id", Eq, Raw("ANY(?)", ids))                                // WHERE id = ANY($2)
+//
+// Parameters:
+//   - sql: The SQL fragment.
+//   - args: The values of the placeholders.
+//
+// Returns:
+//   - *RawExpr: A pointer to a new RawExpr instance.
+func Raw(sql string, args ...any) *RawExpr {
+	return &RawExpr{SQL: sql, Args: args}
+}
+
+// String generates the fragment with the arguments inlined for the default dialect.
+func (e *RawExpr) String() string {
+	return e.render(nil)
+}
+
+// render generates the SQL of String with the dialect d, the default dialect when nil.
+func (e *RawExpr) render(d Dialect) string {
+	sql, _, _ := e.expand(d, nil, false)
+
+	return sql
+}
+
+// StringArgs generates the fragment with the placeholders of the default dialect and appends
+// its arguments to the arguments slice.
+func (e *RawExpr) StringArgs(args []any) (string, []any) {
+	sql, args, _ := e.renderArgs(nil, args)
+
+	return sql, args
+}
+
+// renderArgs generates the SQL of StringArgs with the dialect d, the default dialect when nil.
+func (e *RawExpr) renderArgs(d Dialect, args []any) (string, []any, error) {
+	return e.expand(d, args, true)
+}
+
+// expand replaces the placeholders of the fragment, copying the quoted strings and identifiers.
+//
+// Parameters:
+//   - d: The dialect of the builder, the default dialect when nil.
+//   - args []any: A slice of arguments.
+//   - bind: Whether the arguments are bound as parameters, or inlined as literals.
+//
+// Returns:
+//   - string: The SQL of the fragment.
+//   - []any: The slice of arguments, with one argument appended per bound placeholder.
+//   - error: ErrMissingParam for a placeholder without argument, when bound.
+func (e *RawExpr) expand(d Dialect, args []any, bind bool) (string, []any, error) {
+	var sb strings.Builder
+	position := 0
+
+	for i := 0; i < len(e.SQL); i++ {
+		c := e.SQL[i]
+
+		// Copy quoted strings and identifiers.
+		if c == '\'' || c == '"' || c == '`' {
+			end := strings.IndexByte(e.SQL[i+1:], c)
+			if end < 0 {
+				sb.WriteString(e.SQL[i:])
+				break
+			}
+
+			sb.WriteString(e.SQL[i : i+end+2])
+			i += end + 1
+
+			continue
+		}
+
+		index := -1
+		next := i + 1
+
+		switch {
+		case c == '?' && next < len(e.SQL) && e.SQL[next] == '?':
+			sb.WriteByte('?')
+			i = next
+
+			continue
+		case c == '?':
+			index = position
+			position++
+		case c == '$':
+			for next < len(e.SQL) && e.SQL[next] >= '0' && e.SQL[next] <= '9' {
+				next++
+			}
+
+			if next > i+1 {
+				index, _ = strconv.Atoi(e.SQL[i+1 : next])
+				index--
+			}
+		}
+
+		if index >= len(e.Args) && bind {
+			return "", args, fmt.Errorf("%w: %s in raw fragment %q", ErrMissingParam, e.SQL[i:next], e.SQL)
+		}
+
+		if index < 0 || index >= len(e.Args) {
+			sb.WriteString(e.SQL[i:next])
+			i = next - 1
+
+			continue
+		}
+
+		if field, ok := e.Args[index].(IValueField); ok {
+			sb.WriteString(field.Value())
+		} else if bind {
+			args = append(args, e.Args[index])
+			sb.WriteString(p(d, args))
+		} else {
+			sb.WriteString(inline(d, e.Args[index]))
+		}
+
+		i = next - 1
+	}
+
+	return sb.String(), args, nil
+}
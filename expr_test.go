@@ -0,0 +1,213 @@
+package fluentsql
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// TestExpr
+func TestExpr(t *testing.T) {
+	originalDialect := defaultDialect
+	defer func() {
+		defaultDialect = originalDialect
+	}()
+
+	SetDialect(new(PostgreSQLDialect))
+
+	testCases := map[string]Expr{
+		"LOWER(email)":                     Func("LOWER", "email"),
+		"COALESCE(nickname, 'anonymous')":  Coalesce("nickname", Arg("anonymous")),
+		"NULLIF(total, 0)":                 NullIf("total", 0),
+		"CAST(price AS numeric(10, 2))":    Cast("price", "numeric(10, 2)"),
+		"price * qty":                      Mul("price", "qty"),
+		"(price - discount) * 1.2":         Mul(Sub("price", "discount"), 1.2),
+		"ROUND(price * qty, 2)":            Func("ROUND", Mul("price", "qty"), 2),
+		`LOWER("user"."email")`:            Func("LOWER", Ident("user.email")),
+		"'O''Brien'":                       Lit("O'Brien"),
+		"price * qty AS total":             Alias(Mul("price", "qty"), "total"),
+		"COALESCE(a, NULL, e.department)":  Coalesce("a", nil, ValueField("e.department")),
+		"(SELECT MAX(id) FROM orders) + 1": Add(QueryInstance().Select("MAX(id)").From("orders"), 1),
+	}
+
+	for expected, expr := range testCases {
+		if expr.String() != expected {
+			t.Fatalf(`Query %s != %s`, expr.String(), expected)
+		}
+	}
+}
+
+// TestExprStringArgs
+func TestExprStringArgs(t *testing.T) {
+	originalDialect := defaultDialect
+	defer func() {
+		defaultDialect = originalDialect
+	}()
+
+	SetDialect(new(PostgreSQLDialect))
+
+	sql, args := Func("ROUND", Mul(Sub("price", Arg(1)), 1.2), Lit(2)).StringArgs([]any{"x"})
+	if sql != "ROUND((price - $2) * $3, 2)" || len(args) != 3 || args[1] != 1 || args[2] != 1.2 {
+		t.Fatalf("Unexpected query %s %v", sql, args)
+	}
+
+	sql, args = Cast(Coalesce("a", Arg("b")), "int").StringArgs(nil)
+	if sql != "CAST(COALESCE(a, $1) AS int)" || len(args) != 1 || args[0] != "b" {
+		t.Fatalf("Unexpected query %s %v", sql, args)
+	}
+}
+
+// TestExprStatement
+func TestExprStatement(t *testing.T) {
+	sql, args, err := QueryInstance().
+		SetDialect(new(PostgreSQLDialect)).
+		Select("id", Alias(Mul("price", "qty"), "total"), Alias(Coalesce("nickname", Arg("n/a")), "name")).
+		From("orders").
+		Where(Mul("price", "qty"), Greater, 100).
+		Where(Func("LOWER", "email"), Eq, "ann@example.com").
+		Where("total", GrEq, Mul(Arg(2), "discount")).
+		OrderBy(Func("ABS", Sub("price", 50)), Asc).
+		Sql()
+
+	expected := "SELECT id, price * qty AS total, COALESCE(nickname, $1) AS name FROM orders " +
+		"WHERE price * qty > $2 AND LOWER(email) = $3 AND total >= $4 * discount ORDER BY ABS(price - $5) ASC"
+	if err != nil || sql != expected {
+		t.Fatalf(`Query %s != %s (%v)`, sql, expected, err)
+	}
+
+	if len(args) != 5 || args[0] != "n/a" || args[1] != 100 || args[3] != 2 || args[4] != 50 {
+		t.Fatalf("Unexpected arguments %v", args)
+	}
+
+	// The field is bound before the value on positional placeholders
+	sql, args, _ = QueryInstance().
+		SetDialect(new(MySQLDialect)).
+		From("orders").
+		Where(Add("qty", 1), Lesser, 10).
+		Sql()
+
+	if sql != "SELECT * FROM orders WHERE qty + ? < ?" || len(args) != 2 || args[0] != 1 || args[1] != 10 {
+		t.Fatalf("Unexpected query %s %v", sql, args)
+	}
+
+	sql, args, _ = UpdateInstance().
+		SetDialect(new(PostgreSQLDialect)).
+		Update("products").
+		Set("price", Mul("price", 1.1)).
+		Set("name", Func("TRIM", Arg(" Tea "))).
+		Where("id", Eq, 1).
+		Sql()
+
+	if sql != "UPDATE products SET price = price * $1, name = TRIM($2) WHERE id = $3" || len(args) != 3 {
+		t.Fatalf("Unexpected query %s %v", sql, args)
+	}
+
+	// Strict mode validates the column names, function names and types of expressions
+	strict := Strict(new(PostgreSQLDialect))
+
+	invalid := []Expr{
+		Func("LOWER", "email; DROP TABLE users"),
+		Func("pg_sleep(10); LOWER", "email"),
+		Cast("price", "int); DROP TABLE users; --"),
+		&BinaryExpr{Left: "a", Op: "; DROP", Right: "b"},
+	}
+
+	for _, expr := range invalid {
+		if _, _, err := QueryInstance().SetDialect(strict).From("users").Where(expr, Eq, 1).Sql(); !errors.Is(err, ErrUnsafeIdent) {
+			t.Fatalf("Expected ErrUnsafeIdent for %s, got %v", expr, err)
+		}
+	}
+}
+
+// TestRaw
+func TestRaw(t *testing.T) {
+	testCases := []struct {
+		dialect  Dialect
+		raw      *RawExpr
+		args     []any
+		expected string
+		bound    []any
+	}{
+		{new(PostgreSQLDialect), Raw("ts > now() - ?::interval", "7 days"), []any{1, 2},
+			"ts > now() - $3::interval", []any{1, 2, "7 days"}},
+		{new(PostgreSQLDialect), Raw("a = $2 OR b = $1 OR c = $2", "x", "y"), []any{1},
+			"a = $2 OR b = $3 OR c = $4", []any{1, "y", "x", "y"}},
+		{new(MySQLDialect), Raw("a BETWEEN ? AND ?", 1, 5), nil,
+			"a BETWEEN ? AND ?", []any{1, 5}},
+		{new(SQLServerDialect), Raw("DATEADD(day, ?, created_at)", 7), []any{1},
+			"DATEADD(day, @p2, created_at)", []any{1, 7}},
+		{new(OracleDialect), Raw("name = $1", "Ann"), nil,
+			"name = :1", []any{"Ann"}},
+		{new(PostgreSQLDialect), Raw("data ?? 'key' AND note = '?' AND id = ?", 3), nil,
+			"data ? 'key' AND note = '?' AND id = $1", []any{3}},
+		{new(PostgreSQLDialect), Raw("id = ? AND $$text$$ = note", 3), nil,
+			"id = $1 AND $$text$$ = note", []any{3}},
+	}
+
+	for _, testCase := range testCases {
+		sql, args, err := testCase.raw.renderArgs(testCase.dialect, testCase.args)
+		if err != nil || sql != testCase.expected {
+			t.Fatalf(`Query %s != %s (%v)`, sql, testCase.expected, err)
+		}
+
+		if fmt.Sprint(args) != fmt.Sprint(testCase.bound) {
+			t.Fatalf("Arguments %v != %v", args, testCase.bound)
+		}
+	}
+
+	if sql := Raw("name = ? AND age > $2", "O'Brien", 30).render(new(MySQLDialect)); sql != "name = 'O''Brien' AND age > 30" {
+		t.Fatalf("Unexpected query %s", sql)
+	}
+
+	// A placeholder without argument is an error
+	for _, raw := range []*RawExpr{Raw("a = $2 AND b = ?", 1), Raw("a = ? AND b = ?", 1), Raw("a = ?")} {
+		_, _, err := QueryInstance().SetDialect(new(PostgreSQLDialect)).From("t").Where(raw, Eq, nil).Sql()
+		if !errors.Is(err, ErrMissingParam) {
+			t.Fatalf("Expected ErrMissingParam for %s, got %v", raw.SQL, err)
+		}
+	}
+}
+
+// TestRawStatement
+func TestRawStatement(t *testing.T) {
+	sql, args, err := QueryInstance().
+		SetDialect(new(PostgreSQLDialect)).
+		Select("n", Raw("n * ? AS doubled", 2)).
+		From(Raw("generate_series(1, ?) AS n", 10)).
+		Join(InnerJoin, Raw("LATERAL (SELECT ? AS k) AS l", "a"), Condition{Field: "l.k", Opt: NotNull}).
+		Where("n", Greater, 1).
+		Where(Raw("created_at > now() - ?::interval", "7 days"), Eq, nil).
+		Where("n", NotEq, Raw("ANY(?)", "{3,4}")).
+		GroupBy("n", Raw("n % ?", 3)).
+		OrderBy(Raw("n <-> ?", 5), Asc).
+		Sql()
+
+	expected := "SELECT n, n * $1 AS doubled FROM generate_series(1, $2) AS n " +
+		"INNER JOIN LATERAL (SELECT $3 AS k) AS l ON l.k IS NOT NULL " +
+		"WHERE n > $4 AND created_at > now() - $5::interval AND n <> ANY($6) " +
+		"GROUP BY n, n % $7 ORDER BY n <-> $8 ASC"
+	if err != nil || sql != expected {
+		t.Fatalf(`Query %s != %s (%v)`, sql, expected, err)
+	}
+
+	if fmt.Sprint(args) != "[2 10 a 1 7 days {3,4} 3 5]" {
+		t.Fatalf("Unexpected arguments %v", args)
+	}
+
+	sql, args, _ = UpdateInstance().
+		SetDialect(new(MySQLDialect)).
+		Update("users").
+		Set("visits", Raw("visits + ?", 1)).
+		Where(Raw("last_seen < NOW() - INTERVAL ? DAY", 30), Eq, nil).
+		Sql()
+
+	if sql != "UPDATE users SET visits = visits + ? WHERE last_seen < NOW() - INTERVAL ? DAY" || fmt.Sprint(args) != "[1 30]" {
+		t.Fatalf("Unexpected query %s %v", sql, args)
+	}
+
+	// Without a nil value, the fragment is the field of the condition
+	where := WhereInstance().Where(Raw("LOWER(?)", "Ann"), Eq, "ann")
+	if where.String() != "WHERE LOWER('Ann') = 'ann'" {
+		t.Fatalf("Unexpected query %s", where.String())
+	}
+}
@@ -8,18 +8,45 @@ type Fetch struct {
 	Fetch int
 	// Offset specifies the number of rows to skip before starting to fetch rows.
 	Offset int
+	// FetchParam binds the number of rows to fetch to a named parameter instead of Fetch.
+	FetchParam Param
+	// OffsetParam binds the number of rows to skip to a named parameter instead of Offset.
+	OffsetParam Param
+}
+
+// isSet reports whether the number of rows to fetch or to skip is set.
+func (f *Fetch) isSet() bool {
+	return f.Fetch > 0 || f.Offset > 0 || f.FetchParam != "" || f.OffsetParam != ""
+}
+
+// values returns the number of rows to fetch and to skip, or their named parameters when set.
+func (f *Fetch) values() (any, any) {
+	var fetch, offset any = f.Fetch, f.Offset
+
+	if f.FetchParam != "" {
+		fetch = f.FetchParam
+	}
+
+	if f.OffsetParam != "" {
+		offset = f.OffsetParam
+	}
+
+	return fetch, offset
 }
 
 // String generates the SQL FETCH clause as a string.
 //
 // If either Fetch or Offset is greater than 0, it returns the string in the format:
 // "OFFSET <Offset> ROWS FETCH NEXT <Fetch> ROWS ONLY". Otherwise, it returns an empty string.
+// Named parameters are written as their name, e.g. ":size".
 //
 // Returns:
 //   - A string representing the SQL FETCH clause.
 func (f *Fetch) String() string {
-	if f.Fetch > 0 || f.Offset > 0 {
-		return fmt.Sprintf("OFFSET %d ROWS FETCH NEXT %d ROWS ONLY", f.Offset, f.Fetch)
+	if f.isSet() {
+		fetch, offset := f.values()
+
+		return fmt.Sprintf("OFFSET %v ROWS FETCH NEXT %v ROWS ONLY", offset, fetch)
 	}
 	return ""
 }
@@ -1,6 +1,12 @@
 package fluentsql
 
-import "fmt"
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
 
 // ====================================================================
 // =========================== Interfaces =============================
@@ -19,6 +25,34 @@ type Dialect interface {
 	// YearFunction returns the SQL function to extract the year from a date.
 	// For example, MySQL uses "YEAR(?)", PostgreSQL uses "DATE_PART('year', ?)"
 	YearFunction(field string) string
+
+	// QuoteIdent quotes an identifier, such as a table or column name.
+	// For example, MySQL uses `name`, PostgreSQL uses "name", SQL Server uses [name].
+	QuoteIdent(name string) string
+
+	// QuoteString quotes a string literal, escaping the characters the dialect interprets.
+	// For example, PostgreSQL uses 'O''Brien', MySQL also escapes backslashes: 'C:\\temp'.
+	QuoteString(value string) string
+
+	// QuoteBytes writes a binary literal.
+	// For example, MySQL uses X'cafe', PostgreSQL uses '\xcafe'::bytea, SQL Server uses 0xcafe.
+	QuoteBytes(value []byte) string
+
+	// Top returns the row limit placed after the SELECT keyword, e.g. "TOP (@p1)",
+	// or an empty string when the dialect limits rows with the clause returned by Limit.
+	// The row count is bound after the other values of the statement, so a dialect with a TOP clause
+	// must use numbered placeholders.
+	Top(limit string) string
+
+	// Limit returns the clause placed at the end of a statement to page its rows. limit and offset are ints, or
+	// Params bound when the statement is executed; an int is zero when not set.
+	// bind renders a value as a placeholder, or inlines it, and must be called in the order of the values in the clause.
+	// For example, PostgreSQL uses "LIMIT $1 OFFSET $2", SQL Server uses "OFFSET @p1 ROWS FETCH NEXT @p2 ROWS ONLY".
+	Limit(limit, offset any, bind func(value any) string) string
+
+	// SubqueryAlias returns a subquery followed by its alias.
+	// For example, PostgreSQL uses "(SELECT ...) AS t", Oracle has no AS before table aliases: "(SELECT ...) t".
+	SubqueryAlias(query, alias string) string
 }
 
 // ====================================================================
@@ -28,7 +62,7 @@ type Dialect interface {
 var (
 	// Question is a PlaceholderFormat instance that leaves placeholders as
 	// question marks.
-	// Use for MySQL, SQLite
+	// Use for MySQL, SQLite, ClickHouse
 	question = "?"
 
 	// Dollar is a PlaceholderFormat instance that replaces placeholders with
@@ -36,6 +70,19 @@ var (
 	// Use for PostgreSQL, SQLite
 	dollar = "$"
 
+	// At is a PlaceholderFormat instance that replaces placeholders with
+	// named positional placeholders (e.g. @p1, @p2, @p3).
+	// Use for SQL Server
+	at = "@p"
+
+	// Colon is a PlaceholderFormat instance that replaces placeholders with
+	// colon-prefixed positional bind variables (e.g. :1, :2, :3).
+	// Use for Oracle
+	colon = ":"
+
+	// mysqlEscaper escapes the string literals of MySQL and ClickHouse, which interpret backslash escapes.
+	mysqlEscaper = strings.NewReplacer(`\`, `\\`, `'`, `''`)
+
 	// ------------------------- Dialects -------------------------
 
 	// MySQL is a constant representing the MySQL database type.
@@ -44,21 +91,58 @@ var (
 	PostgreSQL = "PostgreSQL"
 	// SQLite is a constant representing the SQLite database type.
 	SQLite = "SQLite"
+	// SQLServer is a constant representing the Microsoft SQL Server database type.
+	SQLServer = "SQLServer"
+	// Oracle is a constant representing the Oracle database type.
+	Oracle = "Oracle"
+	// ClickHouse is a constant representing the ClickHouse database type.
+	ClickHouse = "ClickHouse"
+
+	// ErrNotSupported is returned by Sql when a statement uses a construct the dialect does not support.
+	ErrNotSupported = errors.New("fluentsql: not supported by dialect")
+
+	// ErrUnsafeIdent is returned by Sql when a strict dialect rejects a table or column name given as a plain string.
+	ErrUnsafeIdent = errors.New("fluentsql: unsafe identifier")
+
+	// ErrMissingParam is returned when binding a statement whose named parameter has no value,
+	// and by Sql when a placeholder of a Raw fragment has no argument.
+	ErrMissingParam = errors.New("fluentsql: missing parameter")
+
+	// ErrUnknownParam is returned when binding a value to a name which is not a parameter of the statement.
+	ErrUnknownParam = errors.New("fluentsql: unknown parameter")
 
-	// defaultDialect is the default dialect. It determines which SQL dialect to use for placeholder formatting.
+	// ErrStructColumns is returned by Sql when a struct given to InsertBuilder.Struct has no field for a column
+	// of the statement, or when its options leave no column to insert.
+	ErrStructColumns = errors.New("fluentsql: struct does not match the columns")
+
+	// ErrMissingTable is returned by Sql when an INSERT statement has no table, e.g. rows given without Insert.
+	ErrMissingTable = errors.New("fluentsql: missing table")
+
+	// defaultDialect is the default dialect. It determines which SQL dialect to use for placeholder formatting
+	// when a builder has no dialect of its own.
 	defaultDialect Dialect = new(PostgreSQLDialect)
+
+	// dialectMutex guards defaultDialect, so SetDialect can be called while statements are generated.
+	dialectMutex sync.RWMutex
 )
 
 // DefaultDialect returns the default dialect.
 // This is for backward compatibility.
 func DefaultDialect() Dialect {
+	dialectMutex.RLock()
+	defer dialectMutex.RUnlock()
+
 	return defaultDialect
 }
 
-// SetDialect sets the current database dialect for placeholder formatting.
+// SetDialect sets the default database dialect, used by the builders without a dialect of their own.
+// Prefer the SetDialect method of the builders when several dialects are used by the same program.
 // Parameters:
 //   - dialect (Dialect): The database dialect to set as the current one.
 func SetDialect(dialect Dialect) {
+	dialectMutex.Lock()
+	defer dialectMutex.Unlock()
+
 	defaultDialect = dialect
 }
 
@@ -69,7 +153,48 @@ func SetDialect(dialect Dialect) {
 // Returns:
 //   - bool: true if the current dialect matches the specified name, false otherwise
 func IsDialect(dialectName string) bool {
-	return defaultDialect.Name() == dialectName
+	return DefaultDialect().Name() == dialectName
+}
+
+// dialectOr returns the given dialect, or the default dialect when it is nil.
+// Parameters:
+//   - d (Dialect): The dialect of the builder, nil if none is set.
+//
+// Returns:
+//   - Dialect: The dialect used to generate the statement.
+func dialectOr(d Dialect) Dialect {
+	if d != nil {
+		return d
+	}
+
+	return DefaultDialect()
+}
+
+// checkDialect checks the dialect of a nested builder against the dialect of the enclosing statement,
+// whose placeholders and quoting are used for the whole statement.
+// Parameters:
+//   - own (Dialect): The dialect of the builder, nil if none is set.
+//   - d (Dialect): The dialect of the enclosing statement, nil for a top-level statement without dialect.
+//
+// Returns:
+//   - error: ErrNotSupported if the builder has a dialect of its own which differs from the dialect of the statement.
+func checkDialect(own, d Dialect) error {
+	if own != nil && d != nil && own.Name() != d.Name() {
+		return fmt.Errorf("%w: %s subquery in a %s statement", ErrNotSupported, own.Name(), d.Name())
+	}
+
+	return nil
+}
+
+// isDialect checks if a dialect, or the default dialect when it is nil, matches the specified dialect name.
+// Parameters:
+//   - d (Dialect): The dialect of the builder, nil if none is set.
+//   - dialectName (string): The name of the dialect to check.
+//
+// Returns:
+//   - bool: true if the dialect matches the specified name, false otherwise
+func isDialect(d Dialect, dialectName string) bool {
+	return dialectOr(d).Name() == dialectName
 }
 
 // ====================================================================
@@ -104,12 +229,65 @@ func (d MySQLDialect) YearFunction(field string) string {
 	return "YEAR(" + field + ")"
 }
 
+// QuoteIdent quotes an identifier for MySQL with backticks, doubling embedded backticks.
+//
+// Parameter:
+//   - name: The table or column name to quote
+//
+// Returns a string containing the quoted identifier (e.g. `order`).
+func (d MySQLDialect) QuoteIdent(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
+
+// QuoteString quotes a string literal for MySQL, doubling single quotes and escaping backslashes.
+func (d MySQLDialect) QuoteString(value string) string {
+	return "'" + mysqlEscaper.Replace(value) + "'"
+}
+
+// QuoteBytes writes a binary literal for MySQL (e.g. X'cafe').
+func (d MySQLDialect) QuoteBytes(value []byte) string {
+	return "X'" + hex.EncodeToString(value) + "'"
+}
+
+// Top returns an empty string, MySQL limits rows with the LIMIT clause.
+func (d MySQLDialect) Top(_ string) string {
+	return ""
+}
+
+// Limit returns the MySQL LIMIT clause.
+//
+// Parameters:
+//   - limit: The maximum number of rows, an int or a Param
+//   - offset: The number of rows to skip, an int or a Param
+//   - bind: Renders a value of the clause
+//
+// Returns a string containing the LIMIT ... OFFSET ... clause.
+func (d MySQLDialect) Limit(limit, offset any, bind func(value any) string) string {
+	return "LIMIT " + bind(limit) + " OFFSET " + bind(offset)
+}
+
+// SubqueryAlias returns the MySQL aliased subquery.
+//
+// Parameters:
+//   - query: The subquery, enclosed in parentheses
+//   - alias: The alias of the subquery
+//
+// Returns a string containing the subquery followed by AS and its alias.
+func (d MySQLDialect) SubqueryAlias(query, alias string) string {
+	return query + " AS " + alias
+}
+
 // ====================================================================
 // ======================== PostgreSQLDialect =========================
 // ====================================================================
 
 // PostgreSQLDialect implements the Dialect interface for PostgreSQL.
-type PostgreSQLDialect struct{}
+type PostgreSQLDialect struct {
+	// ArrayIn binds the values of IN and NOT IN lists as one array parameter, "col = ANY($1)" and "col <> ALL($1)",
+	// so the statement is the same whatever the number of values. The driver must accept slices as arrays,
+	// as pgx does, or the values must be wrapped, e.g. with pq.Array.
+	ArrayIn bool
+}
 
 // Name returns the name of the PostgreSQL dialect.
 func (d PostgreSQLDialect) Name() string {
@@ -136,6 +314,54 @@ func (d PostgreSQLDialect) YearFunction(field string) string {
 	return "DATE_PART('year', " + field + ")"
 }
 
+// QuoteIdent quotes an identifier for PostgreSQL with double quotes, doubling embedded double quotes.
+//
+// Parameter:
+//   - name: The table or column name to quote
+//
+// Returns a string containing the quoted identifier (e.g. "order").
+func (d PostgreSQLDialect) QuoteIdent(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+// QuoteString quotes a string literal for PostgreSQL, doubling single quotes.
+func (d PostgreSQLDialect) QuoteString(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
+
+// QuoteBytes writes a binary literal for PostgreSQL (e.g. '\xcafe'::bytea).
+func (d PostgreSQLDialect) QuoteBytes(value []byte) string {
+	return "'\\x" + hex.EncodeToString(value) + "'::bytea"
+}
+
+// Top returns an empty string, PostgreSQL limits rows with the LIMIT clause.
+func (d PostgreSQLDialect) Top(_ string) string {
+	return ""
+}
+
+// Limit returns the PostgreSQL LIMIT clause.
+//
+// Parameters:
+//   - limit: The maximum number of rows, an int or a Param
+//   - offset: The number of rows to skip, an int or a Param
+//   - bind: Renders a value of the clause
+//
+// Returns a string containing the LIMIT ... OFFSET ... clause.
+func (d PostgreSQLDialect) Limit(limit, offset any, bind func(value any) string) string {
+	return "LIMIT " + bind(limit) + " OFFSET " + bind(offset)
+}
+
+// SubqueryAlias returns the PostgreSQL aliased subquery.
+//
+// Parameters:
+//   - query: The subquery, enclosed in parentheses
+//   - alias: The alias of the subquery
+//
+// Returns a string containing the subquery followed by AS and its alias.
+func (d PostgreSQLDialect) SubqueryAlias(query, alias string) string {
+	return query + " AS " + alias
+}
+
 // ====================================================================
 // ========================== SQLiteDialect ===========================
 // ====================================================================
@@ -168,20 +394,331 @@ func (d SQLiteDialect) YearFunction(field string) string {
 	return "strftime('%Y', " + field + ")"
 }
 
+// QuoteIdent quotes an identifier for SQLite with double quotes, doubling embedded double quotes.
+//
+// Parameter:
+//   - name: The table or column name to quote
+//
+// Returns a string containing the quoted identifier (e.g. "order").
+func (d SQLiteDialect) QuoteIdent(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+// QuoteString quotes a string literal for SQLite, doubling single quotes.
+func (d SQLiteDialect) QuoteString(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
+
+// QuoteBytes writes a binary literal for SQLite (e.g. X'cafe').
+func (d SQLiteDialect) QuoteBytes(value []byte) string {
+	return "X'" + hex.EncodeToString(value) + "'"
+}
+
+// Top returns an empty string, SQLite limits rows with the LIMIT clause.
+func (d SQLiteDialect) Top(_ string) string {
+	return ""
+}
+
+// Limit returns the SQLite LIMIT clause.
+//
+// Parameters:
+//   - limit: The maximum number of rows, an int or a Param
+//   - offset: The number of rows to skip, an int or a Param
+//   - bind: Renders a value of the clause
+//
+// Returns a string containing the LIMIT ... OFFSET ... clause.
+func (d SQLiteDialect) Limit(limit, offset any, bind func(value any) string) string {
+	return "LIMIT " + bind(limit) + " OFFSET " + bind(offset)
+}
+
+// SubqueryAlias returns the SQLite aliased subquery.
+//
+// Parameters:
+//   - query: The subquery, enclosed in parentheses
+//   - alias: The alias of the subquery
+//
+// Returns a string containing the subquery followed by AS and its alias.
+func (d SQLiteDialect) SubqueryAlias(query, alias string) string {
+	return query + " AS " + alias
+}
+
+// ====================================================================
+// ======================== SQLServerDialect ==========================
+// ====================================================================
+
+// SQLServerDialect implements the Dialect interface for Microsoft SQL Server.
+type SQLServerDialect struct{}
+
+// Name returns the name of the SQL Server dialect.
+func (d SQLServerDialect) Name() string {
+	return SQLServer
+}
+
+// Placeholder returns the placeholder for SQL Server, which is "@pn" where n is the position.
+//
+// Parameter:
+//   - position: The position of the placeholder (1-based)
+//
+// Returns a string containing the named position (e.g. "@p1", "@p2", etc).
+func (d SQLServerDialect) Placeholder(position int) string {
+	return at + fmt.Sprintf("%d", position)
+}
+
+// YearFunction returns the SQL Server-specific function to extract the year from a date.
+//
+// Parameter:
+//   - field: The date field or expression to extract the year from
+//
+// Returns a string containing the SQL Server DATEPART function call.
+func (d SQLServerDialect) YearFunction(field string) string {
+	return "DATEPART(year, " + field + ")"
+}
+
+// QuoteIdent quotes an identifier for SQL Server with brackets, doubling embedded closing brackets.
+//
+// Parameter:
+//   - name: The table or column name to quote
+//
+// Returns a string containing the quoted identifier (e.g. [order]).
+func (d SQLServerDialect) QuoteIdent(name string) string {
+	return "[" + strings.ReplaceAll(name, "]", "]]") + "]"
+}
+
+// QuoteString quotes a string literal for SQL Server, doubling single quotes.
+func (d SQLServerDialect) QuoteString(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
+
+// QuoteBytes writes a binary literal for SQL Server (e.g. 0xcafe).
+func (d SQLServerDialect) QuoteBytes(value []byte) string {
+	return "0x" + hex.EncodeToString(value)
+}
+
+// Top returns the SQL Server TOP clause, used when rows are limited without an offset.
+//
+// Parameter:
+//   - limit: The rendered maximum number of rows
+//
+// Returns a string containing the TOP clause (e.g. "TOP (@p1)").
+func (d SQLServerDialect) Top(limit string) string {
+	return "TOP (" + limit + ")"
+}
+
+// Limit returns the SQL Server paging clause, which requires an ORDER BY clause.
+//
+// Parameters:
+//   - limit: The maximum number of rows, an int or a Param
+//   - offset: The number of rows to skip, an int or a Param
+//   - bind: Renders a value of the clause
+//
+// Returns a string containing the OFFSET ... ROWS clause, followed by FETCH NEXT ... ROWS ONLY when a limit is set.
+func (d SQLServerDialect) Limit(limit, offset any, bind func(value any) string) string {
+	offsetStr := "OFFSET " + bind(offset) + " ROWS"
+	if limit == 0 {
+		return offsetStr
+	}
+
+	return offsetStr + " FETCH NEXT " + bind(limit) + " ROWS ONLY"
+}
+
+// SubqueryAlias returns the SQL Server aliased subquery.
+//
+// Parameters:
+//   - query: The subquery, enclosed in parentheses
+//   - alias: The alias of the subquery
+//
+// Returns a string containing the subquery followed by AS and its alias.
+func (d SQLServerDialect) SubqueryAlias(query, alias string) string {
+	return query + " AS " + alias
+}
+
+// ====================================================================
+// ========================== OracleDialect ===========================
+// ====================================================================
+
+// OracleDialect implements the Dialect interface for Oracle Database (12c and later).
+type OracleDialect struct{}
+
+// Name returns the name of the Oracle dialect.
+func (d OracleDialect) Name() string {
+	return Oracle
+}
+
+// Placeholder returns the bind variable for Oracle, which is ":n" where n is the position.
+//
+// Parameter:
+//   - position: The position of the bind variable (1-based)
+//
+// Returns a string containing the colon-prefixed position (e.g. ":1", ":2", etc).
+func (d OracleDialect) Placeholder(position int) string {
+	return colon + fmt.Sprintf("%d", position)
+}
+
+// YearFunction returns the Oracle-specific function to extract the year from a date.
+//
+// Parameter:
+//   - field: The date field or expression to extract the year from
+//
+// Returns a string containing the Oracle EXTRACT function call.
+func (d OracleDialect) YearFunction(field string) string {
+	return "EXTRACT(YEAR FROM " + field + ")"
+}
+
+// QuoteIdent quotes an identifier for Oracle with double quotes, doubling embedded double quotes.
+// Quoted identifiers are case-sensitive in Oracle.
+//
+// Parameter:
+//   - name: The table or column name to quote
+//
+// Returns a string containing the quoted identifier (e.g. "order").
+func (d OracleDialect) QuoteIdent(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+// QuoteString quotes a string literal for Oracle, doubling single quotes.
+func (d OracleDialect) QuoteString(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
+
+// QuoteBytes writes a binary literal for Oracle (e.g. HEXTORAW('cafe')).
+func (d OracleDialect) QuoteBytes(value []byte) string {
+	return "HEXTORAW('" + hex.EncodeToString(value) + "')"
+}
+
+// Top returns an empty string, Oracle limits rows with the row limiting clause.
+func (d OracleDialect) Top(_ string) string {
+	return ""
+}
+
+// Limit returns the Oracle row limiting clause, which replaces the ROWNUM filters of older releases.
+//
+// Parameters:
+//   - limit: The maximum number of rows, an int or a Param
+//   - offset: The number of rows to skip, an int or a Param
+//   - bind: Renders a value of the clause
+//
+// Returns a string containing the FETCH FIRST ... ROWS ONLY clause, preceded by OFFSET ... ROWS when an offset is set.
+func (d OracleDialect) Limit(limit, offset any, bind func(value any) string) string {
+	if offset == 0 {
+		return "FETCH FIRST " + bind(limit) + " ROWS ONLY"
+	}
+
+	offsetStr := "OFFSET " + bind(offset) + " ROWS"
+	if limit == 0 {
+		return offsetStr
+	}
+
+	return offsetStr + " FETCH NEXT " + bind(limit) + " ROWS ONLY"
+}
+
+// SubqueryAlias returns the Oracle aliased subquery, Oracle has no AS keyword before table aliases.
+//
+// Parameters:
+//   - query: The subquery, enclosed in parentheses
+//   - alias: The alias of the subquery
+//
+// Returns a string containing the subquery followed by its alias.
+func (d OracleDialect) SubqueryAlias(query, alias string) string {
+	return query + " " + alias
+}
+
+// ====================================================================
+// ======================== ClickHouseDialect =========================
+// ====================================================================
+
+// ClickHouseDialect implements the Dialect interface for ClickHouse.
+type ClickHouseDialect struct{}
+
+// Name returns the name of the ClickHouse dialect.
+func (d ClickHouseDialect) Name() string {
+	return ClickHouse
+}
+
+// Placeholder returns the placeholder for ClickHouse, which is "?".
+//
+// Parameter:
+//   - position: Parameter position (not used in ClickHouse)
+//
+// Returns a string containing the question mark placeholder.
+func (d ClickHouseDialect) Placeholder(_ int) string {
+	return question
+}
+
+// YearFunction returns the ClickHouse-specific function to extract the year from a date.
+//
+// Parameter:
+//   - field: The date field or expression to extract the year from
+//
+// Returns a string containing the ClickHouse toYear function call.
+func (d ClickHouseDialect) YearFunction(field string) string {
+	return "toYear(" + field + ")"
+}
+
+// QuoteIdent quotes an identifier for ClickHouse with backticks, doubling embedded backticks.
+//
+// Parameter:
+//   - name: The table or column name to quote
+//
+// Returns a string containing the quoted identifier (e.g. `order`).
+func (d ClickHouseDialect) QuoteIdent(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
+
+// QuoteString quotes a string literal for ClickHouse, doubling single quotes and escaping backslashes.
+func (d ClickHouseDialect) QuoteString(value string) string {
+	return "'" + mysqlEscaper.Replace(value) + "'"
+}
+
+// QuoteBytes writes a binary literal for ClickHouse (e.g. unhex('cafe')).
+func (d ClickHouseDialect) QuoteBytes(value []byte) string {
+	return "unhex('" + hex.EncodeToString(value) + "')"
+}
+
+// Top returns an empty string, ClickHouse limits rows with the LIMIT clause.
+func (d ClickHouseDialect) Top(_ string) string {
+	return ""
+}
+
+// Limit returns the ClickHouse LIMIT clause.
+//
+// Parameters:
+//   - limit: The maximum number of rows, an int or a Param
+//   - offset: The number of rows to skip, an int or a Param
+//   - bind: Renders a value of the clause
+//
+// Returns a string containing the LIMIT ... OFFSET ... clause.
+func (d ClickHouseDialect) Limit(limit, offset any, bind func(value any) string) string {
+	return "LIMIT " + bind(limit) + " OFFSET " + bind(offset)
+}
+
+// SubqueryAlias returns the ClickHouse aliased subquery.
+//
+// Parameters:
+//   - query: The subquery, enclosed in parentheses
+//   - alias: The alias of the subquery
+//
+// Returns a string containing the subquery followed by AS and its alias.
+func (d ClickHouseDialect) SubqueryAlias(query, alias string) string {
+	return query + " AS " + alias
+}
+
 // ====================================================================
 // ============================ Utilities =============================
 // ====================================================================
 
-// p generates the correct placeholder format based on the current database dialect.
+// p generates the correct placeholder format based on the database dialect.
 // Parameters:
+//   - d (Dialect): The dialect of the builder, the default dialect when nil.
 //   - args ([]any): A slice of arguments used to calculate the placeholder number for PostgreSQL.
 //
 // Output:
 //   - (string): The placeholder-formatted string.
 //
 // Notes:
-//   - MySQL and SQLite use question marks (?) for placeholders.
+//   - MySQL, SQLite and ClickHouse use question marks (?) for placeholders.
 //   - PostgreSQL uses dollar-prefixed positional placeholders (e.g., $1, $2).
-func p(args []any) string {
-	return defaultDialect.Placeholder(len(args))
+//   - SQL Server uses named positional placeholders (e.g., @p1, @p2).
+//   - Oracle uses colon-prefixed positional bind variables (e.g., :1, :2).
+func p(d Dialect, args []any) string {
+	return dialectOr(d).Placeholder(len(args))
 }
@@ -1,6 +1,10 @@
 package fluentsql
 
-import "testing"
+import (
+	"errors"
+	"sync"
+	"testing"
+)
 
 func TestSetDialect(t *testing.T) {
 	// Save the original dialect to restore it after the test
@@ -129,3 +133,599 @@ func TestSQLiteDialect_YearFunction(t *testing.T) {
 		t.Fatalf("Expected SQLite year function to be %s, got %s", expected, result)
 	}
 }
+
+func TestSQLServerDialect_Name(t *testing.T) {
+	dialect := new(SQLServerDialect)
+	if dialect.Name() != SQLServer {
+		t.Fatalf("Expected SQL Server dialect name to be %s, got %s", SQLServer, dialect.Name())
+	}
+}
+
+func TestSQLServerDialect_Placeholder(t *testing.T) {
+	dialect := new(SQLServerDialect)
+	if dialect.Placeholder(1) != "@p1" {
+		t.Fatalf("Expected SQL Server placeholder to be @p1, got %s", dialect.Placeholder(1))
+	}
+	if dialect.Placeholder(2) != "@p2" {
+		t.Fatalf("Expected SQL Server placeholder to be @p2, got %s", dialect.Placeholder(2))
+	}
+}
+
+func TestSQLServerDialect_YearFunction(t *testing.T) {
+	dialect := new(SQLServerDialect)
+	expected := "DATEPART(year, hire_date)"
+	result := dialect.YearFunction("hire_date")
+	if result != expected {
+		t.Fatalf("Expected SQL Server year function to be %s, got %s", expected, result)
+	}
+}
+
+func TestOracleDialect_Name(t *testing.T) {
+	dialect := new(OracleDialect)
+	if dialect.Name() != Oracle {
+		t.Fatalf("Expected Oracle dialect name to be %s, got %s", Oracle, dialect.Name())
+	}
+}
+
+func TestOracleDialect_Placeholder(t *testing.T) {
+	dialect := new(OracleDialect)
+	if dialect.Placeholder(1) != ":1" {
+		t.Fatalf("Expected Oracle placeholder to be :1, got %s", dialect.Placeholder(1))
+	}
+	if dialect.Placeholder(2) != ":2" {
+		t.Fatalf("Expected Oracle placeholder to be :2, got %s", dialect.Placeholder(2))
+	}
+}
+
+func TestOracleDialect_YearFunction(t *testing.T) {
+	dialect := new(OracleDialect)
+	expected := "EXTRACT(YEAR FROM hire_date)"
+	result := dialect.YearFunction("hire_date")
+	if result != expected {
+		t.Fatalf("Expected Oracle year function to be %s, got %s", expected, result)
+	}
+}
+
+func TestClickHouseDialect_Name(t *testing.T) {
+	dialect := new(ClickHouseDialect)
+	if dialect.Name() != ClickHouse {
+		t.Fatalf("Expected ClickHouse dialect name to be %s, got %s", ClickHouse, dialect.Name())
+	}
+}
+
+func TestClickHouseDialect_Placeholder(t *testing.T) {
+	dialect := new(ClickHouseDialect)
+	if dialect.Placeholder(1) != "?" {
+		t.Fatalf("Expected ClickHouse placeholder to be ?, got %s", dialect.Placeholder(1))
+	}
+}
+
+func TestClickHouseDialect_YearFunction(t *testing.T) {
+	dialect := new(ClickHouseDialect)
+	expected := "toYear(event_date)"
+	result := dialect.YearFunction("event_date")
+	if result != expected {
+		t.Fatalf("Expected ClickHouse year function to be %s, got %s", expected, result)
+	}
+}
+
+func TestDialect_QuoteIdent(t *testing.T) {
+	testCases := []struct {
+		dialect  Dialect
+		name     string
+		expected string
+	}{
+		{new(MySQLDialect), "order", "`order`"},
+		{new(MySQLDialect), "ord`er", "`ord``er`"},
+		{new(PostgreSQLDialect), "order", `"order"`},
+		{new(PostgreSQLDialect), `ord"er`, `"ord""er"`},
+		{new(SQLiteDialect), "order", `"order"`},
+		{new(SQLServerDialect), "order", "[order]"},
+		{new(SQLServerDialect), "ord]er", "[ord]]er]"},
+		{new(OracleDialect), "order", `"order"`},
+		{new(ClickHouseDialect), "order", "`order`"},
+	}
+
+	for _, testCase := range testCases {
+		result := testCase.dialect.QuoteIdent(testCase.name)
+		if result != testCase.expected {
+			t.Fatalf("Expected %s identifier to be %s, got %s", testCase.dialect.Name(), testCase.expected, result)
+		}
+	}
+}
+
+func TestSQLServerDialect(t *testing.T) {
+	sqlServer := new(SQLServerDialect)
+
+	// Rows are limited with TOP, bound after the other values
+	sql, args, err := QueryInstance().
+		SetDialect(sqlServer).
+		Select("id", FieldYear("hire_date")).
+		From("employees").
+		Where("department_id", Eq, 3).
+		OrderBy("id", Asc).
+		Limit(10, 0).
+		Sql()
+
+	expected := "SELECT TOP (@p2) id, DATEPART(year, hire_date) FROM employees WHERE department_id = @p1 ORDER BY id ASC"
+	if err != nil || sql != expected {
+		t.Fatalf("Query %s != %s (%v)", sql, expected, err)
+	}
+
+	if len(args) != 2 || args[0] != 3 || args[1] != 10 {
+		t.Fatalf("Unexpected arguments %v", args)
+	}
+
+	// An offset pages the rows with OFFSET ... FETCH, which requires an ORDER BY clause
+	query := QueryInstance().
+		SetDialect(sqlServer).
+		Select("id").
+		Distinct().
+		From("employees").
+		Limit(10, 20)
+
+	sql, args, err = query.Sql()
+
+	expected = "SELECT DISTINCT id FROM employees ORDER BY (SELECT NULL) OFFSET @p1 ROWS FETCH NEXT @p2 ROWS ONLY"
+	if err != nil || sql != expected {
+		t.Fatalf("Query %s != %s (%v)", sql, expected, err)
+	}
+
+	if len(args) != 2 || args[0] != 20 || args[1] != 10 {
+		t.Fatalf("Unexpected arguments %v", args)
+	}
+
+	expected = "SELECT DISTINCT id FROM employees ORDER BY (SELECT NULL) OFFSET 20 ROWS FETCH NEXT 10 ROWS ONLY"
+	if query.String() != expected {
+		t.Fatalf("Query %s != %s", query.String(), expected)
+	}
+
+	expected = "SELECT DISTINCT TOP (5) id FROM employees"
+	if query.Limit(5, 0).String() != expected {
+		t.Fatalf("Query %s != %s", query.String(), expected)
+	}
+
+	// An offset without limit skips the rows only
+	expected = "SELECT DISTINCT id FROM employees ORDER BY (SELECT NULL) OFFSET 20 ROWS"
+	if query.Limit(0, 20).String() != expected {
+		t.Fatalf("Query %s != %s", query.String(), expected)
+	}
+
+	sql, _, _ = CompoundInstance(QueryInstance().Select("id").From("a")).
+		Union(QueryInstance().Select("id").From("b")).
+		SetDialect(sqlServer).
+		Limit(10, 0).
+		Sql()
+
+	expected = "SELECT id FROM a UNION SELECT id FROM b ORDER BY (SELECT NULL) OFFSET @p1 ROWS FETCH NEXT @p2 ROWS ONLY"
+	if sql != expected {
+		t.Fatalf("Query %s != %s", sql, expected)
+	}
+
+	// RETURNING is generated as an OUTPUT clause
+	sql, args, err = InsertInstance().
+		SetDialect(sqlServer).
+		Insert("users", "name", "email").
+		Row("Ann", "ann@example.com").
+		Returning("id", "created_at").
+		Sql()
+
+	expected = "INSERT INTO users (name, email) OUTPUT inserted.id, inserted.created_at VALUES (@p1, @p2)"
+	if err != nil || sql != expected {
+		t.Fatalf("Query %s != %s (%v)", sql, expected, err)
+	}
+
+	if len(args) != 2 {
+		t.Fatalf("Unexpected arguments %v", args)
+	}
+
+	sql, args, err = UpdateInstance().
+		SetDialect(sqlServer).
+		Update("users").
+		Set("active", false).
+		Where("last_login", Lesser, "2020-01-01").
+		Returning("*").
+		Sql()
+
+	expected = "UPDATE users SET active = @p1 OUTPUT inserted.* WHERE last_login < @p2"
+	if err != nil || sql != expected {
+		t.Fatalf("Query %s != %s (%v)", sql, expected, err)
+	}
+
+	if len(args) != 2 {
+		t.Fatalf("Unexpected arguments %v", args)
+	}
+
+	deleteQuery := DeleteInstance().
+		SetDialect(sqlServer).
+		Delete("jobs").
+		Where("done", Eq, true).
+		Returning("id")
+
+	sql, _, err = deleteQuery.Sql()
+
+	expected = "DELETE FROM jobs OUTPUT deleted.id WHERE done = @p1"
+	if err != nil || sql != expected {
+		t.Fatalf("Query %s != %s (%v)", sql, expected, err)
+	}
+
+	expected = "DELETE FROM jobs OUTPUT deleted.id WHERE done = 1"
+	if deleteQuery.String() != expected {
+		t.Fatalf("Query %s != %s", deleteQuery.String(), expected)
+	}
+
+	// Upserts and row locking clauses are not supported
+	_, _, err = InsertInstance().
+		SetDialect(sqlServer).
+		Insert("users", "email").
+		Row("ann@example.com").
+		OnConflict("email").
+		DoNothing().
+		Sql()
+	if !errors.Is(err, ErrNotSupported) {
+		t.Fatalf("Expected ErrNotSupported, got %v", err)
+	}
+
+	_, _, err = QueryInstance().SetDialect(sqlServer).Select("id").From("jobs").ForUpdate().Sql()
+	if !errors.Is(err, ErrNotSupported) {
+		t.Fatalf("Expected ErrNotSupported, got %v", err)
+	}
+}
+
+func TestBuilderDialect(t *testing.T) {
+	// The builder dialect overrides the default dialect, subqueries inherit it
+	query := QueryInstance().
+		SetDialect(new(MySQLDialect)).
+		Select("id", FieldYear("hire_date")).
+		From("employees").
+		Where("department_id", In, QueryInstance().
+			Select("id").
+			From("departments").
+			Where("name", Eq, "IT"),
+		).
+		Where(FieldYear("hire_date"), Eq, 1999).
+		Limit(10, 0)
+
+	sql, args, err := query.Sql()
+
+	expected := "SELECT id, YEAR(hire_date) FROM employees WHERE department_id IN (SELECT id FROM departments WHERE name = ?) AND YEAR(hire_date) = ? LIMIT ? OFFSET ?"
+	if err != nil || sql != expected {
+		t.Fatalf("Query %s != %s (%v)", sql, expected, err)
+	}
+
+	if len(args) != 4 {
+		t.Fatalf("Unexpected arguments %v", args)
+	}
+
+	expected = "SELECT id, YEAR(hire_date) FROM employees WHERE department_id IN (SELECT id FROM departments WHERE name = 'IT') AND YEAR(hire_date) = 1999 LIMIT 10 OFFSET 0"
+	if query.String() != expected {
+		t.Fatalf("Query %s != %s", query.String(), expected)
+	}
+
+	// Dialect checks use the builder dialect
+	_, _, err = DeleteInstance().SetDialect(new(MySQLDialect)).Delete("jobs").Returning("id").Sql()
+	if !errors.Is(err, ErrNotSupported) {
+		t.Fatalf("Expected ErrNotSupported, got %v", err)
+	}
+
+	sql, _, _ = InsertInstance().
+		SetDialect(new(SQLiteDialect)).
+		Insert("users", "name").
+		Query(QueryInstance().Select("name").From("staff").Where("id", Eq, 1)).
+		Sql()
+
+	if sql != "INSERT INTO users (name) SELECT name FROM staff WHERE id = ?" {
+		t.Fatalf("Unexpected query %s", sql)
+	}
+
+	sql, _, _ = UpdateInstance().SetDialect(new(SQLiteDialect)).Update("users").Set("name", "Ann").Where("id", Eq, 1).Sql()
+	if sql != "UPDATE users SET name = ? WHERE id = ?" {
+		t.Fatalf("Unexpected query %s", sql)
+	}
+
+	sql, _ = WhereInstance().SetDialect(new(MySQLDialect)).Where("id", Eq, 1).StringArgs(nil)
+	if sql != "WHERE id = ?" {
+		t.Fatalf("Unexpected query %s", sql)
+	}
+
+	sql, _, _ = CompoundInstance(QueryInstance().Select("id").From("a").Where("x", Eq, 1)).
+		Union(QueryInstance().Select("id").From("b").Where("x", Eq, 2)).
+		SetDialect(new(SQLiteDialect)).
+		Sql()
+
+	if sql != "SELECT id FROM a WHERE x = ? UNION SELECT id FROM b WHERE x = ?" {
+		t.Fatalf("Unexpected query %s", sql)
+	}
+
+	// A subquery with the same dialect of its own, and one with a different dialect
+	sql, _, _ = QueryInstance().
+		SetDialect(new(PostgreSQLDialect)).
+		From("users").
+		Where("id", In, QueryInstance().SetDialect(new(PostgreSQLDialect)).Select(Ident("user_id")).From("orders").Where("x", Eq, 1)).
+		Sql()
+
+	if sql != `SELECT * FROM users WHERE id IN (SELECT "user_id" FROM orders WHERE x = $1)` {
+		t.Fatalf("Unexpected query %s", sql)
+	}
+
+	_, _, err = QueryInstance().
+		SetDialect(new(PostgreSQLDialect)).
+		From("users").
+		Where("id", In, QueryInstance().SetDialect(new(MySQLDialect)).Select(Ident("user_id")).From("orders")).
+		Sql()
+
+	if !errors.Is(err, ErrNotSupported) {
+		t.Fatalf("Expected ErrNotSupported, got %v", err)
+	}
+}
+
+func TestBuilderDialectConcurrent(t *testing.T) {
+	originalDialect := DefaultDialect()
+	defer SetDialect(originalDialect)
+
+	dialects := map[string]Dialect{
+		"SELECT id FROM users WHERE id = $1 AND name = $2": new(PostgreSQLDialect),
+		"SELECT id FROM users WHERE id = ? AND name = ?":   new(SQLiteDialect),
+	}
+
+	var wg sync.WaitGroup
+
+	// The default dialect changes while the builders generate their statements
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		for i := 0; i < 100; i++ {
+			SetDialect(new(MySQLDialect))
+			SetDialect(new(PostgreSQLDialect))
+		}
+	}()
+
+	for expected, dialect := range dialects {
+		wg.Add(1)
+		go func(expected string, dialect Dialect) {
+			defer wg.Done()
+
+			for i := 0; i < 100; i++ {
+				sql, _, _ := QueryInstance().
+					SetDialect(dialect).
+					Select("id").
+					From("users").
+					Where("id", Eq, 1).
+					Where("name", Eq, "Ann").
+					Sql()
+
+				if sql != expected {
+					t.Errorf("Query %s != %s", sql, expected)
+					return
+				}
+			}
+		}(expected, dialect)
+	}
+
+	wg.Wait()
+}
+
+func TestOracleDialect(t *testing.T) {
+	oracle := new(OracleDialect)
+
+	// Derived tables have no AS before their alias, rows are limited with FETCH FIRST
+	query := QueryInstance().
+		SetDialect(oracle).
+		Select("e.id", FieldYear("e.hire_date")).
+		From(QueryInstance().Select("*").From("employees").Where("active", Eq, 1).AS("e")).
+		Where("e.department_id", Eq, 3).
+		OrderBy("e.id", Asc).
+		Limit(10, 0)
+
+	sql, args, err := query.Sql()
+
+	expected := "SELECT e.id, EXTRACT(YEAR FROM e.hire_date) FROM (SELECT * FROM employees WHERE active = :1) e WHERE e.department_id = :2 ORDER BY e.id ASC FETCH FIRST :3 ROWS ONLY"
+	if err != nil || sql != expected {
+		t.Fatalf("Query %s != %s (%v)", sql, expected, err)
+	}
+
+	if len(args) != 3 || args[2] != 10 {
+		t.Fatalf("Unexpected arguments %v", args)
+	}
+
+	// The offset is bound before the row count, in the order of the bind variables
+	sql, args, _ = query.Limit(10, 20).Sql()
+
+	expected = "SELECT e.id, EXTRACT(YEAR FROM e.hire_date) FROM (SELECT * FROM employees WHERE active = :1) e WHERE e.department_id = :2 ORDER BY e.id ASC OFFSET :3 ROWS FETCH NEXT :4 ROWS ONLY"
+	if sql != expected {
+		t.Fatalf("Query %s != %s", sql, expected)
+	}
+
+	if len(args) != 4 || args[2] != 20 || args[3] != 10 {
+		t.Fatalf("Unexpected arguments %v", args)
+	}
+
+	expected = "SELECT e.id, EXTRACT(YEAR FROM e.hire_date) FROM (SELECT * FROM employees WHERE active = 1) e WHERE e.department_id = 3 ORDER BY e.id ASC OFFSET 20 ROWS"
+	if query.Limit(0, 20).String() != expected {
+		t.Fatalf("Query %s != %s", query.String(), expected)
+	}
+
+	// Upserts are generated as a MERGE statement
+	insert := InsertInstance().
+		SetDialect(oracle).
+		Insert("users", "email", "name").
+		Row("ann@example.com", "Ann").
+		Row("bob@example.com", "Bob").
+		OnConflict("email").
+		DoUpdate("name", Excluded("name"))
+
+	sql, args, err = insert.Sql()
+
+	expected = "MERGE INTO users USING (SELECT :1 AS email, :2 AS name FROM dual UNION ALL SELECT :3 AS email, :4 AS name FROM dual) excluded ON (users.email = excluded.email) " +
+		"WHEN MATCHED THEN UPDATE SET name = EXCLUDED.name " +
+		"WHEN NOT MATCHED THEN INSERT (email, name) VALUES (excluded.email, excluded.name)"
+	if err != nil || sql != expected {
+		t.Fatalf("Query %s != %s (%v)", sql, expected, err)
+	}
+
+	if len(args) != 4 {
+		t.Fatalf("Unexpected arguments %v", args)
+	}
+
+	sql = InsertInstance().
+		SetDialect(oracle).
+		Insert("users", "email").
+		Row("ann@example.com").
+		OnConflict("email").
+		String()
+
+	expected = "MERGE INTO users USING (SELECT 'ann@example.com' AS email FROM dual) excluded ON (users.email = excluded.email) " +
+		"WHEN NOT MATCHED THEN INSERT (email) VALUES (excluded.email)"
+	if sql != expected {
+		t.Fatalf("Query %s != %s", sql, expected)
+	}
+
+	// Several rows are selected from DUAL, EXCEPT is written MINUS
+	insert = InsertInstance().
+		SetDialect(oracle).
+		Insert("users", "email", "name").
+		Row("ann@example.com", "Ann").
+		Row("bob@example.com", "Bob")
+
+	sql, args, err = insert.Sql()
+
+	expected = "INSERT INTO users (email, name) SELECT :1, :2 FROM dual UNION ALL SELECT :3, :4 FROM dual"
+	if err != nil || sql != expected || len(args) != 4 {
+		t.Fatalf("Query %s != %s (%v, %v)", sql, expected, args, err)
+	}
+
+	expected = "INSERT INTO users (email, name) SELECT 'ann@example.com', 'Ann' FROM dual UNION ALL SELECT 'bob@example.com', 'Bob' FROM dual"
+	if insert.String() != expected {
+		t.Fatalf("Query %s != %s", insert.String(), expected)
+	}
+
+	sql, _, _ = CompoundInstance(QueryInstance().Select("id").From("users")).
+		Except(QueryInstance().Select("user_id").From("bans")).
+		SetDialect(oracle).
+		Sql()
+
+	expected = "SELECT id FROM users MINUS SELECT user_id FROM bans"
+	if sql != expected {
+		t.Fatalf("Query %s != %s", sql, expected)
+	}
+
+	// MERGE requires conflict columns, RETURNING requires output binds, only exclusive locks exist
+	_, _, err = InsertInstance().
+		SetDialect(oracle).
+		Insert("users", "email").
+		Row("ann@example.com").
+		OnConflictConstraint("users_email_key").
+		Sql()
+	if !errors.Is(err, ErrNotSupported) {
+		t.Fatalf("Expected ErrNotSupported, got %v", err)
+	}
+
+	_, _, err = InsertInstance().SetDialect(oracle).Insert("users", "email").Row("ann@example.com").Returning("id").Sql()
+	if !errors.Is(err, ErrNotSupported) {
+		t.Fatalf("Expected ErrNotSupported, got %v", err)
+	}
+
+	_, _, err = QueryInstance().SetDialect(oracle).Select("id").From("jobs").Lock(LockForShare).Sql()
+	if !errors.Is(err, ErrNotSupported) {
+		t.Fatalf("Expected ErrNotSupported, got %v", err)
+	}
+
+	sql, _, err = QueryInstance().SetDialect(oracle).Select("id").From("jobs").ForUpdate().SkipLocked().Sql()
+	if err != nil || sql != "SELECT id FROM jobs FOR UPDATE SKIP LOCKED" {
+		t.Fatalf("Unexpected query %s (%v)", sql, err)
+	}
+}
+
+func TestClickHouseDialect(t *testing.T) {
+	clickHouse := new(ClickHouseDialect)
+
+	query := QueryInstance().
+		SetDialect(clickHouse).
+		Select("user_id", "tag", FieldYear("event_date")).
+		From("events", "e").
+		Final().
+		Sample("0.1").
+		ArrayJoin("tags AS tag").
+		Where("country", Eq, "FR").
+		OrderBy("event_date", Desc).
+		LimitBy(2, 0, "user_id").
+		Limit(100, 0).
+		Settings("max_threads", 8)
+
+	sql, args, err := query.Sql()
+
+	expected := "SELECT user_id, tag, toYear(event_date) FROM events e FINAL SAMPLE 0.1 ARRAY JOIN tags AS tag WHERE country = ? " +
+		"ORDER BY event_date DESC LIMIT ? BY user_id LIMIT ? OFFSET ? SETTINGS max_threads = 8"
+	if err != nil || sql != expected {
+		t.Fatalf("Query %s != %s (%v)", sql, expected, err)
+	}
+
+	if len(args) != 4 || args[1] != 2 || args[2] != 100 {
+		t.Fatalf("Unexpected arguments %v", args)
+	}
+
+	expected = "SELECT user_id, tag, toYear(event_date) FROM events e FINAL SAMPLE 0.1 ARRAY JOIN tags AS tag WHERE country = 'FR' " +
+		"ORDER BY event_date DESC LIMIT 2 BY user_id LIMIT 100 OFFSET 0 SETTINGS max_threads = 8"
+	if query.String() != expected {
+		t.Fatalf("Query %s != %s", query.String(), expected)
+	}
+
+	sql, _, _ = QueryInstance().
+		SetDialect(clickHouse).
+		Select("id", "tag").
+		From("posts").
+		LeftArrayJoin("tags AS tag").
+		Sql()
+
+	if sql != "SELECT id, tag FROM posts LEFT ARRAY JOIN tags AS tag" {
+		t.Fatalf("Unexpected query %s", sql)
+	}
+
+	// The ClickHouse clauses are rejected by the other dialects
+	_, _, err = QueryInstance().SetDialect(new(MySQLDialect)).Select("id").From("events").Final().Sql()
+	if !errors.Is(err, ErrNotSupported) {
+		t.Fatalf("Expected ErrNotSupported, got %v", err)
+	}
+
+	_, _, err = QueryInstance().SetDialect(new(MySQLDialect)).Select("id").From("posts").ArrayJoin("tags").Sql()
+	if !errors.Is(err, ErrNotSupported) {
+		t.Fatalf("Expected ErrNotSupported, got %v", err)
+	}
+
+	_, _, err = QueryInstance().SetDialect(new(MySQLDialect)).Select("id").From("events").Settings("max_threads", 8).Sql()
+	if !errors.Is(err, ErrNotSupported) {
+		t.Fatalf("Expected ErrNotSupported, got %v", err)
+	}
+
+	// ClickHouse has no row locking, RETURNING or upsert, and no RIGHT JOIN with the direct join algorithm
+	_, _, err = QueryInstance().SetDialect(clickHouse).Select("id").From("events").ForUpdate().Sql()
+	if !errors.Is(err, ErrNotSupported) {
+		t.Fatalf("Expected ErrNotSupported, got %v", err)
+	}
+
+	_, _, err = InsertInstance().SetDialect(clickHouse).Insert("events", "id").Row(1).Returning("id").Sql()
+	if !errors.Is(err, ErrNotSupported) {
+		t.Fatalf("Expected ErrNotSupported, got %v", err)
+	}
+
+	_, _, err = InsertInstance().SetDialect(clickHouse).Insert("events", "id").Row(1).OnConflict("id").Sql()
+	if !errors.Is(err, ErrNotSupported) {
+		t.Fatalf("Expected ErrNotSupported, got %v", err)
+	}
+
+	rightJoin := QueryInstance().
+		SetDialect(clickHouse).
+		Select("e.id", "u.name").
+		From("events", "e").
+		Join(RightJoin, "users_dict u", Condition{Field: "e.user_id", Opt: Eq, Value: ValueField("u.id")})
+
+	if _, _, err = rightJoin.Sql(); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	_, _, err = rightJoin.Settings("join_algorithm", "direct").Sql()
+	if !errors.Is(err, ErrNotSupported) {
+		t.Fatalf("Expected ErrNotSupported, got %v", err)
+	}
+}
@@ -7,10 +7,95 @@ import (
 
 // From clause
 type From struct {
-	// Table represents the table name or a nested query. It can be of type string or *QueryBuilder.
+	// Table represents the table name or a nested query. It can be of type string, Ident, Expr, *QueryBuilder or *CompoundBuilder.
 	Table any
 	// Alias defines an alias for the table or query in the SQL statement.
 	Alias string
+	// Final merges the rows of a ClickHouse MergeTree table before reading them (FINAL).
+	Final bool
+	// Sample reads a sample of a ClickHouse table, e.g. "0.1", "10000000" or "1/10 OFFSET 1/2" (SAMPLE).
+	Sample string
+}
+
+// validate checks the table modifiers against the dialect.
+//
+// Parameters:
+//   - d: The dialect of the builder, the default dialect when nil.
+//
+// Returns:
+//   - error: ErrNotSupported if FINAL or SAMPLE is used outside ClickHouse.
+func (f *From) validate(d Dialect) error {
+	if (f.Final || f.Sample != "") && !isDialect(d, ClickHouse) {
+		return fmt.Errorf("%w: %s FINAL / SAMPLE", ErrNotSupported, dialectOr(d).Name())
+	}
+
+	return nil
+}
+
+// modifiers generates the ClickHouse table modifiers placed after the table and its alias.
+// They are omitted when the dialect is not ClickHouse; validate reports them instead.
+//
+// Parameters:
+//   - d: The dialect of the builder, the default dialect when nil.
+//
+// Returns:
+//   - string: E.g. " FINAL SAMPLE 0.1", or an empty string.
+func (f *From) modifiers(d Dialect) string {
+	if !isDialect(d, ClickHouse) {
+		return ""
+	}
+
+	var sb strings.Builder
+
+	if f.Final {
+		sb.WriteString(" FINAL")
+	}
+
+	if f.Sample != "" {
+		sb.WriteString(" SAMPLE " + f.Sample)
+	}
+
+	return sb.String()
+}
+
+// tableSql generates the table of a FROM or JOIN clause.
+//
+// Parameters:
+//   - d: The dialect of the builder, the default dialect when nil.
+//   - table: A table name, an Ident, an expression such as a raw fragment, or a nested query, written in parentheses
+//     followed by the alias set with AS.
+//
+// Returns:
+//   - string: The SQL of the table.
+func tableSql(d Dialect, table any) string {
+	switch value := table.(type) {
+	case string:
+		return value
+	case Ident: // Quoted by the dialect
+		return value.render(d)
+	case *QueryBuilder:
+		selectQuery := value.render(d)
+
+		// If the QueryBuilder has no alias, wrap the query in parentheses
+		if value.alias == "" {
+			return "(" + selectQuery + ")"
+		}
+
+		return selectQuery
+	case *CompoundBuilder:
+		selectQuery := value.render(d)
+
+		// If the CompoundBuilder has no alias, wrap the query in parentheses
+		if value.alias == "" {
+			return "(" + selectQuery + ")"
+		}
+
+		return selectQuery
+	case Expr: // E.g. a raw fragment
+		return value.render(d)
+	}
+
+	return ""
 }
 
 // String generates the SQL representation of the "FROM" clause.
@@ -20,20 +105,15 @@ type From struct {
 // or a nested query (using a *QueryBuilder). An optional Alias
 // can also be appended to the clause.
 func (f *From) String() string {
-	var sb strings.Builder
+	return f.render(nil)
+}
 
-	// Check if Table is of type string
-	if _, ok := f.Table.(string); ok {
-		sb.WriteString(fmt.Sprintf("FROM %s", f.Table))
-	} else if _, ok := f.Table.(*QueryBuilder); ok { // Check if Table is of type *QueryBuilder
-		selectQuery := f.Table.(*QueryBuilder).String()
+// render generates the SQL of String with the dialect d, the default dialect when nil.
+func (f *From) render(d Dialect) string {
+	var sb strings.Builder
 
-		// If the QueryBuilder has no alias, wrap the query in parentheses
-		if f.Table.(*QueryBuilder).alias == "" {
-			sb.WriteString(fmt.Sprintf("FROM (%s)", selectQuery))
-		} else {
-			sb.WriteString(fmt.Sprintf("FROM %s", selectQuery))
-		}
+	if f.Table != nil {
+		sb.WriteString("FROM " + tableSql(d, f.Table))
 	}
 
 	// Append the alias if it is not empty
@@ -41,5 +121,8 @@ func (f *From) String() string {
 		sb.WriteString(" " + f.Alias)
 	}
 
+	// Append the ClickHouse modifiers
+	sb.WriteString(f.modifiers(d))
+
 	return sb.String()
 }
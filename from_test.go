@@ -48,3 +48,28 @@ func TestFromSelect(t *testing.T) {
 		t.Fatalf(`Query %s != %s`, fromTest.String(), expected)
 	}
 }
+
+// TestFromModifiers
+func TestFromModifiers(t *testing.T) {
+	originalDialect := defaultDialect
+	defer func() {
+		defaultDialect = originalDialect
+	}()
+
+	fromTest := From{Table: "events", Alias: "e", Final: true, Sample: "0.1"}
+
+	SetDialect(new(ClickHouseDialect))
+
+	expected := "FROM events e FINAL SAMPLE 0.1"
+	if fromTest.String() != expected {
+		t.Fatalf(`Query %s != %s`, fromTest.String(), expected)
+	}
+
+	// FINAL and SAMPLE are omitted outside ClickHouse
+	SetDialect(new(PostgreSQLDialect))
+
+	expected = "FROM events e"
+	if fromTest.String() != expected {
+		t.Fatalf(`Query %s != %s`, fromTest.String(), expected)
+	}
+}
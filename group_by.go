@@ -8,25 +8,239 @@ import (
 // GroupBy clause
 type GroupBy struct {
 	// Items stores the list of fields that will be grouped by in the query.
-	Items []string
+	// Items can be strings, Idents, expressions or GroupingSets.
+	Items []any
+}
+
+// Kinds of GroupingSet.
+const (
+	rollup       = "ROLLUP"
+	cube         = "CUBE"
+	groupingSets = "GROUPING SETS"
+)
+
+// GroupingSet represents a ROLLUP, CUBE or GROUPING SETS item of a GROUP BY clause, which groups the rows
+// by several sets of fields in one query.
+//
+// MySQL writes a ROLLUP as "a, b WITH ROLLUP" and supports it as the only item of the clause, without composite
+// fields. MySQL does not support CUBE and GROUPING SETS, SQLite supports none of them.
+type GroupingSet struct {
+	// Kind is "ROLLUP", "CUBE" or "GROUPING SETS".
+	Kind string
+	// Sets holds the fields of the item. A set of several fields is a composite field of ROLLUP and CUBE,
+	// e.g. ROLLUP((year, quarter), month), and a grouping set of GROUPING SETS, written in parentheses.
+	Sets [][]any
+}
+
+// Rollup creates a ROLLUP item, grouping by the fields, then by the leading fields, down to the grand total.
+//
+// Example:
+//
+//	Rollup("year", "month") // ROLLUP(year, month)
+//
+// Parameters:
+//   - fields: The fields, strings, Idents or expressions.
+//
+// Returns:
+//   - GroupingSet: The ROLLUP item.
+func Rollup(fields ...any) GroupingSet {
+	return GroupingSet{Kind: rollup, Sets: singleSets(fields)}
+}
+
+// Cube creates a CUBE item, grouping by every combination of the fields.
+//
+// Example:
+//
+//	Cube("region", "product") // CUBE(region, product)
+//
+// Parameters:
+//   - fields: The fields, strings, Idents or expressions.
+//
+// Returns:
+//   - GroupingSet: The CUBE item.
+func Cube(fields ...any) GroupingSet {
+	return GroupingSet{Kind: cube, Sets: singleSets(fields)}
+}
+
+// GroupingSets creates a GROUPING SETS item, grouping by each set of fields. An empty set is the grand total.
+//
+// Example:
+//
+//	GroupingSets([]any{"a", "b"}, []any{"a"}, nil) // GROUPING SETS ((a, b), (a), ())
+//
+// Parameters:
+//   - sets: The sets of fields.
+//
+// Returns:
+//   - GroupingSet: The GROUPING SETS item.
+func GroupingSets(sets ...[]any) GroupingSet {
+	return GroupingSet{Kind: groupingSets, Sets: sets}
+}
+
+// Grouping creates a GROUPING(field, ...) expression for a select column, whose bits are 1 for the fields
+// aggregated in the row of a ROLLUP, CUBE or GROUPING SETS item. SQL Server accepts a single field.
+func Grouping(fields ...any) *FuncExpr {
+	return Func("GROUPING", fields...)
+}
+
+// singleSets returns the fields as sets of a single field.
+func singleSets(fields []any) [][]any {
+	sets := make([][]any, 0, len(fields))
+	for _, field := range fields {
+		sets = append(sets, []any{field})
+	}
+
+	return sets
 }
 
 // Append adds one or more fields to the GroupBy clause.
 //
 // Parameters:
-//   - field: One or more strings representing the fields to group by.
-func (g *GroupBy) Append(field ...string) {
+//   - field: One or more strings, Idents, expressions or GroupingSets representing the fields to group by.
+func (g *GroupBy) Append(field ...any) {
 	g.Items = append(g.Items, field...)
 }
 
+// validate checks the grouping sets against the dialect.
+//
+// Parameters:
+//   - d: The dialect of the builder, the default dialect when nil.
+//
+// Returns:
+//   - error: ErrNotSupported if a grouping set is used with SQLite, or with MySQL except for a single ROLLUP
+//     without composite fields.
+func (g *GroupBy) validate(d Dialect) error {
+	for _, item := range g.Items {
+		set, ok := item.(GroupingSet)
+		if !ok {
+			continue
+		}
+
+		if isDialect(d, SQLite) || (isDialect(d, MySQL) && !g.withRollup()) {
+			return fmt.Errorf("%w: %s GROUP BY %s", ErrNotSupported, dialectOr(d).Name(), set.Kind)
+		}
+	}
+
+	return nil
+}
+
+// withRollup reports whether the clause is a single ROLLUP without composite fields, written with WITH ROLLUP by MySQL.
+func (g *GroupBy) withRollup() bool {
+	if len(g.Items) != 1 {
+		return false
+	}
+
+	set, ok := g.Items[0].(GroupingSet)
+	if !ok || set.Kind != rollup {
+		return false
+	}
+
+	for _, fields := range set.Sets {
+		if len(fields) != 1 {
+			return false
+		}
+	}
+
+	return true
+}
+
 // String converts the GroupBy clause to its SQL string representation.
 //
 // Returns:
 //   - string: The SQL representation of the GroupBy clause. Returns an empty string if no fields are added.
 func (g *GroupBy) String() string {
+	return g.render(nil)
+}
+
+// render generates the SQL of String with the dialect d, the default dialect when nil.
+func (g *GroupBy) render(d Dialect) string {
+	sql, _, _ := g.build(d, nil, false)
+
+	return sql
+}
+
+// build generates the GROUP BY clause for the dialect d, in the order of its parameters.
+//
+// Parameters:
+//   - d: The dialect of the builder, the default dialect when nil.
+//   - args []any: A slice of arguments.
+//   - bind: Whether the values of the expressions are bound as parameters, or inlined as literals.
+//
+// Returns:
+//   - string: The GROUP BY clause. Returns an empty string if no fields are added.
+//   - []any: The updated slice of arguments.
+//   - error: The error of an expression, when bound.
+func (g *GroupBy) build(d Dialect, args []any, bind bool) (string, []any, error) {
 	if len(g.Items) == 0 {
-		return ""
+		return "", args, nil
+	}
+
+	// err holds the first error of the bound expressions.
+	var err error
+
+	field := func(item any) string {
+		var value any
+		if bind && err == nil {
+			value, args, err = dialectFieldArgs(d, item, args)
+		} else {
+			value = dialectField(d, item)
+		}
+
+		return fmt.Sprintf("%s", value)
+	}
+
+	fields := func(items []any) string {
+		var sql []string
+		for _, item := range items {
+			sql = append(sql, field(item))
+		}
+
+		return strings.Join(sql, ", ")
+	}
+
+	// MySQL: GROUP BY a, b WITH ROLLUP
+	if isDialect(d, MySQL) && g.withRollup() {
+		var items []any
+		for _, set := range g.Items[0].(GroupingSet).Sets {
+			items = append(items, set...)
+		}
+
+		sql := fmt.Sprintf("GROUP BY %s WITH ROLLUP", fields(items))
+		if err != nil {
+			return "", args, err
+		}
+
+		return sql, args, nil
+	}
+
+	var items []string
+	for _, item := range g.Items {
+		set, ok := item.(GroupingSet)
+		if !ok {
+			items = append(items, field(item))
+
+			continue
+		}
+
+		var sets []string
+		for _, setFields := range set.Sets {
+			if len(setFields) == 1 && set.Kind != groupingSets {
+				sets = append(sets, field(setFields[0]))
+			} else {
+				sets = append(sets, "("+fields(setFields)+")")
+			}
+		}
+
+		if set.Kind == groupingSets {
+			items = append(items, fmt.Sprintf("%s (%s)", set.Kind, strings.Join(sets, ", ")))
+		} else {
+			items = append(items, fmt.Sprintf("%s(%s)", set.Kind, strings.Join(sets, ", ")))
+		}
+	}
+
+	if err != nil {
+		return "", args, err
 	}
 
-	return fmt.Sprintf("GROUP BY %s", strings.Join(g.Items, ", "))
+	return fmt.Sprintf("GROUP BY %s", strings.Join(items, ", ")), args, nil
 }
@@ -1,6 +1,8 @@
 package fluentsql
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 )
 
@@ -30,3 +32,76 @@ func TestGroupByColumns(t *testing.T) {
 		t.Fatalf(`Query %s != %s`, groupByTest.String(), expected)
 	}
 }
+
+// TestGroupBySets
+func TestGroupBySets(t *testing.T) {
+	testCases := []struct {
+		dialect  Dialect
+		items    []any
+		expected string
+	}{
+		{new(PostgreSQLDialect), []any{Rollup("year", "month")}, "GROUP BY ROLLUP(year, month)"},
+		{new(SQLServerDialect), []any{"region", Rollup("year", "month")}, "GROUP BY region, ROLLUP(year, month)"},
+		{new(MySQLDialect), []any{Rollup("year", "month")}, "GROUP BY year, month WITH ROLLUP"},
+		{new(OracleDialect), []any{Cube("region", "product")}, "GROUP BY CUBE(region, product)"},
+		{new(PostgreSQLDialect), []any{GroupingSets([]any{"a", "b"}, []any{"a"}, nil)}, "GROUP BY GROUPING SETS ((a, b), (a), ())"},
+		{new(ClickHouseDialect), []any{GroupingSets([]any{"a"}, []any{"b"})}, "GROUP BY GROUPING SETS ((a), (b))"},
+		{new(PostgreSQLDialect), []any{GroupingSet{Kind: "ROLLUP", Sets: [][]any{{"year", "quarter"}, {"month"}}}},
+			"GROUP BY ROLLUP((year, quarter), month)"},
+		{new(PostgreSQLDialect), []any{Func("DATE_TRUNC", Lit("month"), "created_at")}, "GROUP BY DATE_TRUNC('month', created_at)"},
+	}
+
+	for _, testCase := range testCases {
+		groupBy := GroupBy{Items: testCase.items}
+		if sql := groupBy.render(testCase.dialect); sql != testCase.expected {
+			t.Fatalf(`Query %s != %s`, sql, testCase.expected)
+		}
+	}
+}
+
+// TestGroupBySetsStatement
+func TestGroupBySetsStatement(t *testing.T) {
+	sql, args, err := QueryInstance().
+		SetDialect(new(PostgreSQLDialect)).
+		Select(Alias(Func("DATE_TRUNC", Arg("month"), "created_at"), "month"), "region", Grouping("region"), Sum("amount")).
+		From("orders").
+		Where("status", Eq, "paid").
+		GroupBy(Func("DATE_TRUNC", Arg("month"), "created_at"), Rollup("region")).
+		Sql()
+
+	expected := "SELECT DATE_TRUNC($1, created_at) AS month, region, GROUPING(region), SUM(amount) FROM orders " +
+		"WHERE status = $2 GROUP BY DATE_TRUNC($3, created_at), ROLLUP(region)"
+	if err != nil || sql != expected {
+		t.Fatalf(`Query %s != %s (%v)`, sql, expected, err)
+	}
+
+	if fmt.Sprint(args) != "[month paid month]" {
+		t.Fatalf("Unexpected arguments %v", args)
+	}
+
+	unsupported := []struct {
+		dialect Dialect
+		items   []any
+	}{
+		{new(SQLiteDialect), []any{Rollup("year")}},
+		{new(MySQLDialect), []any{"region", Rollup("year")}},
+		{new(MySQLDialect), []any{Cube("year")}},
+		{new(MySQLDialect), []any{GroupingSets([]any{"year"})}},
+	}
+
+	for _, testCase := range unsupported {
+		_, _, err = QueryInstance().SetDialect(testCase.dialect).From("orders").GroupBy(testCase.items...).Sql()
+		if !errors.Is(err, ErrNotSupported) {
+			t.Fatalf("Expected ErrNotSupported for %v, got %v", testCase.items, err)
+		}
+	}
+
+	_, _, err = QueryInstance().
+		SetDialect(Strict(new(PostgreSQLDialect))).
+		From("orders").
+		GroupBy(GroupingSets([]any{"year; DROP TABLE orders"})).
+		Sql()
+	if !errors.Is(err, ErrUnsafeIdent) {
+		t.Fatalf("Expected ErrUnsafeIdent, got %v", err)
+	}
+}
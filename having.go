@@ -17,12 +17,17 @@ type Having struct {
 //
 //	string - The generated HAVING clause as a string.
 func (w *Having) String() string {
+	return w.render(nil)
+}
+
+// render generates the SQL of String with the dialect d, the default dialect when nil.
+func (w *Having) render(d Dialect) string {
 	var conditions []string // Tracks individual condition strings.
 
 	// Iterate through the provided conditions and format them into a single SQL string.
 	if len(w.Conditions) > 0 {
 		for _, cond := range w.Conditions {
-			var _condition = cond.String() // Convert the condition to a string.
+			var _condition = cond.render(d) // Convert the condition to a string.
 
 			// Check if the condition is combined with OR and there are existing conditions.
 			if cond.AndOr == Or && len(conditions) > 0 {
@@ -0,0 +1,465 @@
+package fluentsql
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Ident represents a table or column name quoted by the dialect of the statement, so reserved words
+// such as "order" or "user" and names from user input are safe to use.
+// Dots separate the qualifiers of a name, e.g. Ident("public.users") renders as "public"."users"
+// on PostgreSQL, and a trailing "*" is left unquoted, e.g. Ident("u.*") renders as "u".*.
+//
+// Ident can be used wherever the builders take a table or column name as any:
+// From, Join, Insert, Update, Delete, Select columns, conditions, Set, GroupBy and OrderBy.
+type Ident string
+
+// String quotes the identifier with the default dialect.
+//
+// Returns:
+//   - string: E.g. "order" on PostgreSQL, `order` on MySQL, [order] on SQL Server.
+func (i Ident) String() string {
+	return i.render(nil)
+}
+
+// StringArgs quotes the identifier with the default dialect, the arguments slice is unchanged.
+func (i Ident) StringArgs(args []any) (string, []any) {
+	sql, args, _ := i.renderArgs(nil, args)
+
+	return sql, args
+}
+
+// renderArgs generates the SQL of StringArgs with the dialect d, the default dialect when nil.
+func (i Ident) renderArgs(d Dialect, args []any) (string, []any, error) {
+	return i.render(d), args, nil
+}
+
+// render quotes the identifier with the dialect d, the default dialect when nil.
+func (i Ident) render(d Dialect) string {
+	d = dialectOr(d)
+
+	parts := strings.Split(string(i), ".")
+	for index, part := range parts {
+		if part != "*" {
+			parts[index] = d.QuoteIdent(part)
+		}
+	}
+
+	return strings.Join(parts, ".")
+}
+
+// Safe patterns of the bare string identifiers accepted in strict mode.
+var (
+	// identPattern matches a column name, optionally qualified, e.g. "u.name".
+	identPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)*$`)
+
+	// tablePattern matches a table name, optionally qualified and followed by an alias, e.g. "users AS u".
+	tablePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)*(\s+([Aa][Ss]\s+)?[A-Za-z_][A-Za-z0-9_]*)?$`)
+
+	// collationPattern matches a collation name, optionally qualified or double-quoted, e.g. "utf8mb4_bin" or `"en_US.utf8"`.
+	collationPattern = regexp.MustCompile(`^([A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)*|"[A-Za-z0-9_.@-]+")$`)
+
+	// samplePattern matches a ClickHouse sample ratio or row count with an optional offset, e.g. "1/10 OFFSET 1/2".
+	samplePattern = regexp.MustCompile(`^\d+(\.\d+)?(/\d+)?(\s+(?i:OFFSET)\s+\d+(\.\d+)?(/\d+)?)?$`)
+)
+
+// strictDialect wraps a dialect to validate the bare string identifiers of the statements.
+type strictDialect struct {
+	Dialect
+}
+
+// Strict returns the dialect d in strict mode. With a strict dialect, Sql and StringArgs validate the table
+// and column names given as plain strings against a safe pattern (letters, digits and underscores,
+// optionally qualified with dots) and return ErrUnsafeIdent on a violation.
+// Use Ident for names that do not match the pattern, and ValueField for expressions.
+// Select columns and RETURNING columns may hold expressions and are not validated, the windows of window
+// functions, DISTINCT ON, LIMIT BY, the tables of a lock, the collations of ORDER BY and the ClickHouse SAMPLE are.
+// The names of ClickHouse settings are always validated.
+//
+// Example:
+//
+//	fluentsql.SetDialect(fluentsql.Strict(new(fluentsql.PostgreSQLDialect)))
+//
+// Parameters:
+//   - d: The dialect to wrap, the default dialect when nil.
+//
+// Returns:
+//   - Dialect: The strict dialect.
+func Strict(d Dialect) Dialect {
+	d = dialectOr(d)
+	if isStrict(d) {
+		return d
+	}
+
+	return strictDialect{Dialect: d}
+}
+
+// isStrict checks if a dialect, or the default dialect when it is nil, is in strict mode.
+func isStrict(d Dialect) bool {
+	_, ok := dialectOr(d).(strictDialect)
+
+	return ok
+}
+
+// checkIdents validates column names against identPattern.
+//
+// Returns:
+//   - error: ErrUnsafeIdent for the first name that does not match.
+func checkIdents(names ...string) error {
+	for _, name := range names {
+		if !identPattern.MatchString(name) {
+			return fmt.Errorf("%w: %q", ErrUnsafeIdent, name)
+		}
+	}
+
+	return nil
+}
+
+// checkTable validates a table name, with an optional alias, against tablePattern.
+//
+// Returns:
+//   - error: ErrUnsafeIdent when the name does not match.
+func checkTable(name string) error {
+	if !tablePattern.MatchString(name) {
+		return fmt.Errorf("%w: %q", ErrUnsafeIdent, name)
+	}
+
+	return nil
+}
+
+// checkField validates a field given as a plain string, a FieldNot, a FieldYear or an expression.
+// Other types, such as Ident or ValueField, are not validated.
+func checkField(field any) error {
+	switch value := field.(type) {
+	case string:
+		return checkIdents(value)
+	case Expr:
+		return checkOperand(value)
+	case FieldNot:
+		return checkIdents(string(value))
+	case FieldYear:
+		return checkIdents(string(value))
+	}
+
+	return nil
+}
+
+// checkQuery validates the identifiers of a nested query, any other value is ignored.
+func checkQuery(value any) error {
+	switch query := value.(type) {
+	case *QueryBuilder:
+		return query.checkIdents()
+	case *CompoundBuilder:
+		return query.checkIdents()
+	}
+
+	return nil
+}
+
+// checkConditions validates the fields of conditions and groups of conditions, and the queries of their values.
+func checkConditions(conditions []Condition) error {
+	for _, condition := range conditions {
+		if len(condition.Group) > 0 {
+			if err := checkConditions(condition.Group); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if err := checkField(condition.Field); err != nil {
+			return err
+		}
+
+		if err := checkQuery(condition.Value); err != nil {
+			return err
+		}
+
+		if expr, ok := condition.Value.(Expr); ok {
+			if err := checkOperand(expr); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkWith validates the queries of the common table expressions.
+func checkWith(with With) error {
+	for _, item := range with.Items {
+		if err := checkIdents(item.Name); err != nil {
+			return err
+		}
+
+		if err := checkIdents(item.Columns...); err != nil {
+			return err
+		}
+
+		if err := checkQuery(item.Query); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkOrderBy validates the fields and the collations of an ORDER BY clause.
+func checkOrderBy(orderBy OrderBy) error {
+	for _, item := range orderBy.Items {
+		if err := checkField(item.Field); err != nil {
+			return err
+		}
+
+		if item.Collation != "" && !collationPattern.MatchString(item.Collation) {
+			return fmt.Errorf("%w: %q", ErrUnsafeIdent, item.Collation)
+		}
+	}
+
+	return nil
+}
+
+// checkWindow validates the names, the PARTITION BY expressions and the ORDER BY fields of a window specification.
+func checkWindow(spec *WindowSpec) error {
+	if spec.Base != "" {
+		if err := checkIdents(spec.Base); err != nil {
+			return err
+		}
+	}
+
+	if err := checkIdents(spec.Partition...); err != nil {
+		return err
+	}
+
+	return checkOrderBy(spec.Order)
+}
+
+// checkSet validates the fields of a SET clause and the queries of its values.
+func checkSet(set UpdateSet) error {
+	for _, item := range set.Items {
+		if fields, ok := item.Field.([]string); ok {
+			if err := checkIdents(fields...); err != nil {
+				return err
+			}
+		} else if err := checkField(item.Field); err != nil {
+			return err
+		}
+
+		if err := checkQuery(item.Value); err != nil {
+			return err
+		}
+
+		if expr, ok := item.Value.(Expr); ok {
+			if err := checkOperand(expr); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkIdents validates the bare string identifiers of the query and of its nested queries.
+func (qb *QueryBuilder) checkIdents() error {
+	if err := checkWith(qb.withStatement); err != nil {
+		return err
+	}
+
+	if err := checkIdents(qb.selectStatement.DistinctOn...); err != nil {
+		return err
+	}
+
+	for _, column := range qb.selectStatement.Columns {
+		if err := checkQuery(column); err != nil {
+			return err
+		}
+
+		// The window of a window function column holds column names
+		if window, ok := column.(*WindowFunction); ok && window.Window != nil {
+			if err := checkWindow(window.Window); err != nil {
+				return err
+			}
+		}
+	}
+
+	if table, ok := qb.fromStatement.Table.(string); ok {
+		if err := checkTable(table); err != nil {
+			return err
+		}
+	} else if err := checkQuery(qb.fromStatement.Table); err != nil {
+		return err
+	}
+
+	if qb.fromStatement.Alias != "" {
+		if err := checkIdents(qb.fromStatement.Alias); err != nil {
+			return err
+		}
+	}
+
+	if qb.fromStatement.Sample != "" && !samplePattern.MatchString(qb.fromStatement.Sample) {
+		return fmt.Errorf("%w: %q", ErrUnsafeIdent, qb.fromStatement.Sample)
+	}
+
+	for _, item := range qb.joinStatement.Items {
+		if table, ok := item.Table.(string); ok {
+			if err := checkTable(table); err != nil {
+				return err
+			}
+		}
+
+		if err := checkQuery(item.Table); err != nil {
+			return err
+		}
+
+		if err := checkConditions(append([]Condition{item.Condition}, item.On...)); err != nil {
+			return err
+		}
+
+		if err := checkIdents(item.Using...); err != nil {
+			return err
+		}
+	}
+
+	if err := checkConditions(qb.whereStatement.Conditions); err != nil {
+		return err
+	}
+
+	for _, item := range qb.groupByStatement.Items {
+		if set, ok := item.(GroupingSet); ok {
+			for _, fields := range set.Sets {
+				for _, field := range fields {
+					if err := checkField(field); err != nil {
+						return err
+					}
+				}
+			}
+
+			continue
+		}
+
+		if err := checkField(item); err != nil {
+			return err
+		}
+	}
+
+	if err := checkConditions(qb.havingStatement.Conditions); err != nil {
+		return err
+	}
+
+	for _, item := range qb.windowStatement.Items {
+		if err := checkIdents(item.Name); err != nil {
+			return err
+		}
+
+		if err := checkWindow(&item.Spec); err != nil {
+			return err
+		}
+	}
+
+	if err := checkOrderBy(qb.orderByStatement); err != nil {
+		return err
+	}
+
+	if err := checkIdents(qb.limitByStatement.By...); err != nil {
+		return err
+	}
+
+	return checkIdents(qb.lockStatement.Of...)
+}
+
+// checkIdents validates the bare string identifiers of the combined queries and of the ORDER BY clause.
+func (cb *CompoundBuilder) checkIdents() error {
+	for _, item := range cb.items {
+		if err := checkQuery(item.Query); err != nil {
+			return err
+		}
+	}
+
+	return checkOrderBy(cb.orderByStatement)
+}
+
+// checkIdents validates the bare string identifiers of the INSERT statement and of its query.
+func (ib *InsertBuilder) checkIdents() error {
+	if err := checkWith(ib.withStatement); err != nil {
+		return err
+	}
+
+	if table, ok := ib.insertStatement.Table.(string); ok {
+		if err := checkTable(table); err != nil {
+			return err
+		}
+	}
+
+	if err := checkIdents(ib.insertStatement.Columns...); err != nil {
+		return err
+	}
+
+	if err := checkQuery(ib.queryStatement.Query); err != nil {
+		return err
+	}
+
+	if err := checkIdents(ib.conflictStatement.Columns...); err != nil {
+		return err
+	}
+
+	if err := checkSet(ib.conflictStatement.Set); err != nil {
+		return err
+	}
+
+	return checkConditions(ib.conflictStatement.Where.Conditions)
+}
+
+// checkIdents validates the bare string identifiers of the UPDATE statement and of its nested queries.
+func (ub *UpdateBuilder) checkIdents() error {
+	if err := checkWith(ub.withStatement); err != nil {
+		return err
+	}
+
+	if table, ok := ub.updateStatement.Table.(string); ok {
+		if err := checkTable(table); err != nil {
+			return err
+		}
+	}
+
+	if ub.updateStatement.Alias != "" {
+		if err := checkIdents(ub.updateStatement.Alias); err != nil {
+			return err
+		}
+	}
+
+	if err := checkSet(ub.setStatement); err != nil {
+		return err
+	}
+
+	if err := checkConditions(ub.whereStatement.Conditions); err != nil {
+		return err
+	}
+
+	return checkOrderBy(ub.orderByStatement)
+}
+
+// checkIdents validates the bare string identifiers of the DELETE statement and of its nested queries.
+func (db *DeleteBuilder) checkIdents() error {
+	if err := checkWith(db.withStatement); err != nil {
+		return err
+	}
+
+	if table, ok := db.deleteStatement.Table.(string); ok {
+		if err := checkTable(table); err != nil {
+			return err
+		}
+	}
+
+	if db.deleteStatement.Alias != "" {
+		if err := checkIdents(db.deleteStatement.Alias); err != nil {
+			return err
+		}
+	}
+
+	if err := checkConditions(db.whereStatement.Conditions); err != nil {
+		return err
+	}
+
+	return checkOrderBy(db.orderByStatement)
+}
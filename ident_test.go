@@ -0,0 +1,139 @@
+package fluentsql
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestIdent
+func TestIdent(t *testing.T) {
+	testCases := []struct {
+		dialect  Dialect
+		ident    Ident
+		expected string
+	}{
+		{new(PostgreSQLDialect), "order", `"order"`},
+		{new(PostgreSQLDialect), "public.users", `"public"."users"`},
+		{new(PostgreSQLDialect), "u.*", `"u".*`},
+		{new(MySQLDialect), "user", "`user`"},
+		{new(SQLServerDialect), "dbo.order", "[dbo].[order]"},
+		{new(SQLiteDialect), `a"b`, `"a""b"`},
+	}
+
+	for _, testCase := range testCases {
+		if testCase.ident.render(testCase.dialect) != testCase.expected {
+			t.Fatalf(`Query %s != %s`, testCase.ident.render(testCase.dialect), testCase.expected)
+		}
+	}
+}
+
+// TestIdentStatement
+func TestIdentStatement(t *testing.T) {
+	originalDialect := defaultDialect
+	defer func() {
+		defaultDialect = originalDialect
+	}()
+
+	SetDialect(new(MySQLDialect))
+
+	query := QueryInstance().
+		Select(Ident("u.id"), Ident("order")).
+		From(Ident("user"), "u").
+		Join(InnerJoin, Ident("group"), Condition{Field: Ident("group.id"), Opt: Eq, Value: ValueField("u.group_id")}).
+		Where(Ident("select"), Eq, 1).
+		GroupBy(Ident("order"), "u.id").
+		OrderBy(Ident("order"), Desc)
+
+	expected := "SELECT `u`.`id`, `order` FROM `user` u INNER JOIN `group` ON `group`.`id` = u.group_id WHERE `select` = 1 GROUP BY `order`, u.id ORDER BY `order` DESC"
+	if query.String() != expected {
+		t.Fatalf(`Query %s != %s`, query.String(), expected)
+	}
+
+	sql, args, err := query.SetDialect(new(SQLServerDialect)).Sql()
+	expected = "SELECT [u].[id], [order] FROM [user] u INNER JOIN [group] ON [group].[id] = u.group_id WHERE [select] = @p1 GROUP BY [order], u.id ORDER BY [order] DESC"
+	if err != nil || sql != expected || len(args) != 1 {
+		t.Fatalf(`Query %s != %s (%v %v)`, sql, expected, args, err)
+	}
+
+	sql, _, _ = InsertInstance().SetDialect(new(PostgreSQLDialect)).Insert(Ident("user"), "name").Row("Ann").Sql()
+	if sql != `INSERT INTO "user" (name) VALUES ($1)` {
+		t.Fatalf("Unexpected query %s", sql)
+	}
+
+	sql, _, _ = UpdateInstance().SetDialect(new(PostgreSQLDialect)).Update(Ident("user")).Set(Ident("order"), 2).Where("id", Eq, 1).Sql()
+	if sql != `UPDATE "user" SET "order" = $1 WHERE id = $2` {
+		t.Fatalf("Unexpected query %s", sql)
+	}
+
+	sql, _, _ = DeleteInstance().SetDialect(new(PostgreSQLDialect)).Delete(Ident("user")).Where("id", Eq, 1).Sql()
+	if sql != `DELETE FROM "user" WHERE id = $1` {
+		t.Fatalf("Unexpected query %s", sql)
+	}
+}
+
+// TestStrict
+func TestStrict(t *testing.T) {
+	strict := Strict(new(PostgreSQLDialect))
+
+	if strict.Name() != PostgreSQL || Strict(strict) != strict {
+		t.Fatalf("Unexpected strict dialect %v", strict)
+	}
+
+	sql, _, err := QueryInstance().
+		SetDialect(strict).
+		Select("id", "COUNT(*) AS total").
+		From("users AS u").
+		Where("u.name", Eq, "Ann").
+		GroupBy("id").
+		OrderBy(Ident("sort field"), Asc).
+		Sql()
+
+	expected := `SELECT id, COUNT(*) AS total FROM users AS u WHERE u.name = $1 GROUP BY id ORDER BY "sort field" ASC`
+	if err != nil || sql != expected {
+		t.Fatalf(`Query %s != %s (%v)`, sql, expected, err)
+	}
+
+	testCases := map[string]interface {
+		Sql() (string, []any, error)
+	}{
+		"order by": QueryInstance().SetDialect(strict).From("users").OrderBy("name; DROP TABLE users", Asc),
+		"subquery": QueryInstance().SetDialect(strict).From("users").
+			Where("id", In, QueryInstance().Select("user_id").From("orders").Where("1=1 OR id", Eq, 1)),
+		"group":       QueryInstance().SetDialect(strict).From("users").WhereGroup(func(wb WhereBuilder) *WhereBuilder { return wb.Where("a-b", Eq, 1) }),
+		"join":        QueryInstance().SetDialect(strict).From("users").Join(InnerJoin, "orders o ON 1=1", Condition{Field: "o.user_id", Opt: Eq, Value: ValueField("users.id")}),
+		"insert":      InsertInstance().SetDialect(strict).Insert("users", "name)").Row("Ann"),
+		"update":      UpdateInstance().SetDialect(strict).Update("users").Set("name = 'x', role", "admin"),
+		"delete":      DeleteInstance().SetDialect(strict).Delete("users; --"),
+		"compound":    CompoundInstance(QueryInstance().From("a")).Union(QueryInstance().From("b")).OrderBy("id DESC", Asc).SetDialect(strict),
+		"window":      QueryInstance().SetDialect(strict).From("t").Window("w", WindowInstance().PartitionBy("x; DROP TABLE t")),
+		"over":        QueryInstance().SetDialect(strict).Select(RowNumber().Over(WindowInstance().OrderBy("x; --", Asc))).From("t"),
+		"distinct on": QueryInstance().SetDialect(strict).DistinctOn("id) id, (SELECT 1").From("t"),
+		"lock":        QueryInstance().SetDialect(strict).From("t").ForUpdate("t NOWAIT; --"),
+		"limit by":    QueryInstance().SetDialect(strict).From("t").LimitBy(1, 0, "id; --"),
+		"case":        QueryInstance().SetDialect(strict).From("t").Where("x", Eq, (&Case{Exp: "x; --"}).When(1, "a")),
+		"aggregate":   QueryInstance().SetDialect(strict).From("t").Where("x", Eq, Agg("MAX(1); --", "x")),
+		"collation":   QueryInstance().SetDialect(strict).From("t").OrderBySort(Sort("name", Asc).Collate(`"C"; --`)),
+		"sample":      QueryInstance().SetDialect(Strict(new(ClickHouseDialect))).From("t").Sample("0.1; --"),
+	}
+
+	for name, builder := range testCases {
+		if _, _, err := builder.Sql(); !errors.Is(err, ErrUnsafeIdent) {
+			t.Fatalf("Expected ErrUnsafeIdent for %s, got %v", name, err)
+		}
+	}
+
+	// Safe collations and samples are accepted
+	if _, _, err := QueryInstance().SetDialect(Strict(new(ClickHouseDialect))).From("t").Sample("1/10 OFFSET 1/2").
+		OrderBySort(Sort("name", Asc).Collate("en")).Sql(); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	if _, _, err := QueryInstance().SetDialect(strict).From("t").OrderBySort(Sort("name", Asc).Collate(`"en_US.utf8"`)).Sql(); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	// Without strict mode the identifiers are not validated
+	if _, _, err := QueryInstance().From("users").OrderBy("name DESC NULLS LAST", Asc).Sql(); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+}
@@ -1,7 +1,11 @@
 package fluentsql
 
 import (
+	"fmt"
+	"reflect"
 	"strings"
+
+	"github.com/jivegroup/fluentsql/internal/structmap"
 )
 
 // ====================================================================
@@ -59,12 +63,22 @@ assignment_list:
 // InsertBuilder struct represents a builder for constructing SQL INSERT statements.
 // It contains components for managing the INSERT clause, rows, and query statements.
 type InsertBuilder struct {
+	// dialect overrides the default dialect when set. Subqueries use the dialect of the statement.
+	dialect Dialect
+	// withStatement represents the WITH clause placed before the INSERT statement.
+	withStatement With
 	// insertStatement represents the INSERT clause, including the table name and columns.
 	insertStatement Insert
 	// rowStatement represents the rows to be inserted into the specified table.
 	rowStatement InsertRows
 	// queryStatement represents a subquery for the INSERT statement.
 	queryStatement InsertQuery
+	// conflictStatement represents the upsert clause (ON CONFLICT / ON DUPLICATE KEY UPDATE).
+	conflictStatement OnConflict
+	// returningStatement represents the RETURNING clause of the statement.
+	returningStatement Returning
+	// err is the first error of Struct, returned by Sql.
+	err error
 }
 
 // InsertInstance creates and returns a new instance of InsertBuilder.
@@ -88,20 +102,57 @@ func InsertInstance() *InsertBuilder {
 //
 //	string - A string representation of the SQL INSERT statement.
 func (ib *InsertBuilder) String() string {
+	return ib.render(ib.dialect)
+}
+
+// render generates the SQL of String with the dialect d, the dialect of the enclosing
+// statement for a subquery. The default dialect is used when d is nil.
+func (ib *InsertBuilder) render(d Dialect) string {
+	d = dialectOr(d)
+
+	// Oracle upserts with a MERGE statement.
+	if isDialect(d, Oracle) && ib.conflictStatement.Action != ConflictNone {
+		return ib.renderMerge(d)
+	}
+
 	var queryParts []string
 	var sqlStr string
 
+	// Append the WITH clause if present.
+	sqlStr = ib.withStatement.render(d)
+	if sqlStr != "" {
+		queryParts = append(queryParts, sqlStr)
+	}
+
 	// Append the INSERT clause.
-	queryParts = append(queryParts, ib.insertStatement.String())
+	queryParts = append(queryParts, ib.insertStatement.render(d))
+
+	// Append the OUTPUT clause if present.
+	sqlStr = ib.returningStatement.output(d, "inserted")
+	if sqlStr != "" {
+		queryParts = append(queryParts, sqlStr)
+	}
 
 	// Append the ROWS clause if present.
-	sqlStr = ib.rowStatement.String()
+	sqlStr = ib.rowStatement.render(d)
 	if sqlStr != "" {
 		queryParts = append(queryParts, sqlStr)
 	}
 
 	// Append the QUERY clause if present.
-	sqlStr = ib.queryStatement.String()
+	sqlStr = ib.queryStatement.render(d)
+	if sqlStr != "" {
+		queryParts = append(queryParts, sqlStr)
+	}
+
+	// Append the upsert clause if present.
+	sqlStr = ib.conflictStatement.render(d)
+	if sqlStr != "" {
+		queryParts = append(queryParts, sqlStr)
+	}
+
+	// Append the RETURNING clause if present.
+	sqlStr = ib.returningStatement.render(d)
 	if sqlStr != "" {
 		queryParts = append(queryParts, sqlStr)
 	}
@@ -112,16 +163,64 @@ func (ib *InsertBuilder) String() string {
 	return sql
 }
 
+// With adds a common table expression to the WITH clause placed before the statement.
+//
+// Parameters:
+//   - name (string): The name of the CTE.
+//   - query (any): The body of the CTE, a *QueryBuilder or *CompoundBuilder.
+//   - columns (...string): Optional column names of the CTE.
+//
+// Returns:
+//   - *InsertBuilder: The current InsertBuilder instance.
+func (ib *InsertBuilder) With(name string, query any, columns ...string) *InsertBuilder {
+	ib.withStatement.Append(CTE{
+		Name:    name,
+		Columns: columns,
+		Query:   query,
+	})
+
+	return ib
+}
+
+// WithRecursive adds a common table expression and marks the WITH clause as RECURSIVE.
+// The keyword is omitted under SQL Server and Oracle, whose CTEs are recursive without it.
+//
+// Parameters:
+//   - name (string): The name of the CTE.
+//   - query (any): The body of the CTE, a *QueryBuilder or *CompoundBuilder.
+//   - columns (...string): Optional column names of the CTE.
+//
+// Returns:
+//   - *InsertBuilder: The current InsertBuilder instance.
+func (ib *InsertBuilder) WithRecursive(name string, query any, columns ...string) *InsertBuilder {
+	ib.withStatement.Recursive = true
+
+	return ib.With(name, query, columns...)
+}
+
+// WithCTE appends prepared common table expressions to the WITH clause.
+//
+// Parameters:
+//   - ctes (...CTE): The CTEs to be added.
+//
+// Returns:
+//   - *InsertBuilder: The current InsertBuilder instance.
+func (ib *InsertBuilder) WithCTE(ctes ...CTE) *InsertBuilder {
+	ib.withStatement.Append(ctes...)
+
+	return ib
+}
+
 // Insert sets the table name and column names for the INSERT statement.
 //
 // Parameters:
-//   - table string: The name of the table into which the data will be inserted.
+//   - table any: The name of the table into which the data will be inserted, a string or an Ident.
 //   - columns ...string: The column names for the INSERT statement.
 //
 // Returns:
 //
 //	*InsertBuilder - The updated InsertBuilder instance.
-func (ib *InsertBuilder) Insert(table string, columns ...string) *InsertBuilder {
+func (ib *InsertBuilder) Insert(table any, columns ...string) *InsertBuilder {
 	ib.insertStatement.Table = table
 	ib.insertStatement.Columns = columns
 
@@ -145,13 +244,240 @@ func (ib *InsertBuilder) Row(values ...any) *InsertBuilder {
 // Query sets a subquery for the INSERT statement.
 //
 // Parameters:
-//   - query *QueryBuilder: The subquery to be used in the INSERT statement.
+//   - query any: The subquery to be used in the INSERT statement, a *QueryBuilder or *CompoundBuilder.
 //
 // Returns:
 //
 //	*InsertBuilder - The updated InsertBuilder instance.
-func (ib *InsertBuilder) Query(query *QueryBuilder) *InsertBuilder {
+func (ib *InsertBuilder) Query(query any) *InsertBuilder {
 	ib.queryStatement.Query = query
 
 	return ib
 }
+
+// OnConflict sets the conflict target columns of the upsert and defaults its action to DO NOTHING.
+// On MySQL the columns are not rendered, ON DUPLICATE KEY UPDATE applies to every unique key.
+//
+// Parameters:
+//   - columns ...string: The columns of the unique index that may conflict.
+//
+// Returns:
+//
+//	*InsertBuilder - The updated InsertBuilder instance.
+func (ib *InsertBuilder) OnConflict(columns ...string) *InsertBuilder {
+	ib.conflictStatement.Columns = columns
+
+	if ib.conflictStatement.Action == ConflictNone {
+		ib.conflictStatement.Action = ConflictDoNothing
+	}
+
+	return ib
+}
+
+// OnConflictConstraint sets a named constraint as the conflict target of the upsert (PostgreSQL).
+//
+// Parameters:
+//   - constraint string: The name of the constraint.
+//
+// Returns:
+//
+//	*InsertBuilder - The updated InsertBuilder instance.
+func (ib *InsertBuilder) OnConflictConstraint(constraint string) *InsertBuilder {
+	ib.conflictStatement.Constraint = constraint
+
+	if ib.conflictStatement.Action == ConflictNone {
+		ib.conflictStatement.Action = ConflictDoNothing
+	}
+
+	return ib
+}
+
+// DoNothing skips the rows that conflict with existing ones (ON CONFLICT ... DO NOTHING).
+//
+// Returns:
+//
+//	*InsertBuilder - The updated InsertBuilder instance.
+func (ib *InsertBuilder) DoNothing() *InsertBuilder {
+	ib.conflictStatement.Action = ConflictDoNothing
+
+	return ib
+}
+
+// DoUpdate adds an assignment applied to the existing row on conflict
+// (ON CONFLICT ... DO UPDATE SET on PostgreSQL/SQLite, ON DUPLICATE KEY UPDATE on MySQL).
+//
+// Parameters:
+//   - field any: The column to be updated.
+//   - value any: The value to set, e.g. Excluded("col") to use the proposed value.
+//
+// Returns:
+//
+//	*InsertBuilder - The updated InsertBuilder instance.
+func (ib *InsertBuilder) DoUpdate(field, value any) *InsertBuilder {
+	ib.conflictStatement.Action = ConflictDoUpdate
+	ib.conflictStatement.Set.Append(field, value)
+
+	return ib
+}
+
+// OnDuplicateKeyUpdate adds an assignment applied to the existing row on conflict.
+// It is an alias of DoUpdate following the MySQL naming.
+//
+// Parameters:
+//   - field any: The column to be updated.
+//   - value any: The value to set, e.g. Excluded("col") to use the proposed value.
+//
+// Returns:
+//
+//	*InsertBuilder - The updated InsertBuilder instance.
+func (ib *InsertBuilder) OnDuplicateKeyUpdate(field, value any) *InsertBuilder {
+	return ib.DoUpdate(field, value)
+}
+
+// DoUpdateWhere restricts the rows updated on conflict (PostgreSQL, SQLite).
+//
+// Parameters:
+//   - field any: The field or column to evaluate.
+//   - opt WhereOpt: The conditional operator.
+//   - value any: The value to compare to.
+//
+// Returns:
+//
+//	*InsertBuilder - The updated InsertBuilder instance.
+func (ib *InsertBuilder) DoUpdateWhere(field any, opt WhereOpt, value any) *InsertBuilder {
+	ib.conflictStatement.Where.Append(Condition{
+		Field: field,
+		Opt:   opt,
+		Value: value,
+		AndOr: And,
+	})
+
+	return ib
+}
+
+// RowAlias sets the MySQL row alias of the inserted values (INSERT ... VALUES (...) AS alias).
+// Excluded values are then rendered as alias.col instead of the deprecated VALUES(col).
+//
+// Parameters:
+//   - alias string: The row alias.
+//
+// Returns:
+//
+//	*InsertBuilder - The updated InsertBuilder instance.
+func (ib *InsertBuilder) RowAlias(alias string) *InsertBuilder {
+	ib.conflictStatement.Alias = alias
+
+	return ib
+}
+
+// Returning adds columns or expressions to the RETURNING clause (PostgreSQL, SQLite).
+// Use "*" to return every column of the inserted rows.
+//
+// Parameters:
+//   - columns ...string: The columns or expressions to be returned.
+//
+// Returns:
+//
+//	*InsertBuilder - The updated InsertBuilder instance.
+func (ib *InsertBuilder) Returning(columns ...string) *InsertBuilder {
+	ib.returningStatement.Append(columns...)
+
+	return ib
+}
+
+// Struct appends a row built from the `db` tagged fields of a struct.
+// The first struct sets the columns of the statement when none were given to Insert;
+// later structs, e.g. from Structs, provide the values of the same columns.
+// Sql returns ErrStructColumns when the struct has no field for a column, or when the options select no column.
+//
+// Parameters:
+//   - v any: A struct or a pointer to a struct.
+//   - opts ...StructOptions: Optional selection of the columns, e.g. StructOptions{SkipPrimaryKey: true}.
+//
+// Returns:
+//
+//	*InsertBuilder - The updated InsertBuilder instance.
+//
+// Examples:
+//
+//	InsertInstance().Insert("users").Struct(user, StructOptions{SkipPrimaryKey: true})
+func (ib *InsertBuilder) Struct(v any, opts ...StructOptions) *InsertBuilder {
+	value, meta := structOf(v)
+	if meta == nil {
+		return ib
+	}
+
+	var fields []*structmap.Field
+
+	if len(ib.insertStatement.Columns) == 0 {
+		fields = structFields(value, meta, structOptions(opts))
+
+		if len(fields) == 0 && ib.err == nil {
+			ib.err = fmt.Errorf("%w: no column selected from %s", ErrStructColumns, meta.Type)
+		}
+
+		for _, field := range fields {
+			ib.insertStatement.Columns = append(ib.insertStatement.Columns, field.Name)
+		}
+	} else {
+		for _, column := range ib.insertStatement.Columns {
+			field, ok := meta.Lookup(column)
+			if !ok {
+				if ib.err == nil {
+					ib.err = fmt.Errorf("%w: no field of %s for column %s", ErrStructColumns, meta.Type, column)
+				}
+
+				continue
+			}
+
+			fields = append(fields, field)
+		}
+	}
+
+	values := make([]any, len(fields))
+	for i, field := range fields {
+		if value.IsValid() {
+			values[i] = structValue(value, field)
+		}
+	}
+
+	return ib.Row(values...)
+}
+
+// Structs appends one row per element of a slice of `db` tagged structs. See Struct.
+//
+// Parameters:
+//   - v any: A slice of structs or of pointers to structs.
+//   - opts ...StructOptions: Optional selection of the columns, applied to the first element.
+//
+// Returns:
+//
+//	*InsertBuilder - The updated InsertBuilder instance.
+func (ib *InsertBuilder) Structs(v any, opts ...StructOptions) *InsertBuilder {
+	value := reflect.ValueOf(v)
+	if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+		return ib
+	}
+
+	for i := 0; i < value.Len(); i++ {
+		ib.Struct(value.Index(i).Interface(), opts...)
+	}
+
+	return ib
+}
+
+// SetDialect sets the dialect used to generate the statement instead of the default dialect.
+// Subqueries and CTEs are generated with the same dialect.
+// Sql returns ErrNotSupported for one with a different dialect of its own.
+//
+// Parameters:
+//   - dialect Dialect: The database dialect, e.g. new(MySQLDialect).
+//
+// Returns:
+//
+//	*InsertBuilder - The updated InsertBuilder instance.
+func (ib *InsertBuilder) SetDialect(dialect Dialect) *InsertBuilder {
+	ib.dialect = dialect
+
+	return ib
+}
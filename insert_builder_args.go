@@ -10,13 +10,60 @@ import (
 // Returns:
 //   - string: The complete SQL INSERT statement.
 //   - []any: A slice containing the arguments for the statement.
-//   - error: An error value (always nil in this implementation).
+//   - error: ErrNotSupported when the dialect cannot express the statement, ErrMissingTable when no table is set.
 func (ib *InsertBuilder) Sql() (string, []any, error) {
 	var args []any
 
 	return ib.StringArgs(args)
 }
 
+// Interpolate generates the INSERT statement of Sql with its arguments inlined as literals escaped for the dialect,
+// e.g. for logging or debugging. Execute the statement of Sql with its arguments instead.
+//
+// Returns:
+//   - string: The INSERT statement without placeholders.
+//   - error: The error of Sql, or an error when an argument cannot be written as a literal.
+func (ib *InsertBuilder) Interpolate() (string, error) {
+	sql, args, err := ib.Sql()
+	if err != nil {
+		return "", err
+	}
+
+	return interpolate(ib.dialect, sql, args)
+}
+
+// Compile generates the statement once, for executions binding its named parameters with Bind.
+//
+// Returns:
+// - *Compiled: The compiled statement.
+// - error: The error of Sql.
+func (ib *InsertBuilder) Compile() (*Compiled, error) {
+	return compile(ib.Sql())
+}
+
+// SqlParams generates the statement of Sql with its named parameters bound, see Compiled.Bind.
+//
+// Parameters:
+// - params any: The values of the parameters, a map[string]any or a tagged struct.
+//
+// Returns:
+// - string: The statement with placeholders.
+// - []any: The arguments of the statement.
+// - error: The error of Sql, or of binding the parameters.
+func (ib *InsertBuilder) SqlParams(params any) (string, []any, error) {
+	compiled, err := ib.Compile()
+	if err != nil {
+		return "", nil, err
+	}
+
+	args, err := compiled.Bind(params)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return compiled.Sql(), args, nil
+}
+
 // StringArgs constructs the SQL INSERT statement along with its arguments.
 //
 // Parameters:
@@ -25,23 +72,93 @@ func (ib *InsertBuilder) Sql() (string, []any, error) {
 // Returns:
 //   - string: The constructed SQL INSERT statement.
 //   - []any: A slice containing the arguments for the statement.
-//   - error: An error value (always nil in this implementation).
+//   - error: ErrNotSupported when the dialect cannot express the statement, ErrStructColumns when a struct does not
+//     match the columns, ErrMissingTable when no table is set.
 func (ib *InsertBuilder) StringArgs(args []any) (string, []any, error) {
+	if isStrict(ib.dialect) {
+		if err := ib.checkIdents(); err != nil {
+			return "", args, err
+		}
+	}
+
+	return ib.renderArgs(ib.dialect, args)
+}
+
+// renderArgs generates the SQL of StringArgs with the dialect d, the dialect of the enclosing
+// statement for a subquery. The default dialect is used when d is nil.
+func (ib *InsertBuilder) renderArgs(d Dialect, args []any) (string, []any, error) {
+	if err := checkDialect(ib.dialect, d); err != nil {
+		return "", args, err
+	}
+
+	d = dialectOr(d)
+
+	// A struct which does not match the columns of the statement.
+	if ib.err != nil {
+		return "", args, ib.err
+	}
+
+	if ib.insertStatement.Table == nil || ib.insertStatement.Table == "" {
+		return "", args, ErrMissingTable
+	}
+
+	// Oracle upserts with a MERGE statement.
+	if isDialect(d, Oracle) && ib.conflictStatement.Action != ConflictNone {
+		return ib.renderMergeArgs(d, args)
+	}
+
 	var queryParts []string
 	var sqlStr string
+	var err error
+
+	// Generate SQL string and arguments for the WITH clause.
+	sqlStr, args, err = ib.withStatement.renderArgs(d, args)
+	if err != nil {
+		return "", args, err
+	}
+	if sqlStr != "" {
+		queryParts = append(queryParts, sqlStr)
+	}
 
 	// Generate SQL string and arguments for the INSERT clause.
-	sqlStr, args = ib.insertStatement.StringArgs(args)
+	sqlStr, args = ib.insertStatement.renderArgs(d, args)
 	queryParts = append(queryParts, sqlStr)
 
+	// Generate SQL string for the OUTPUT clause, placed before the inserted rows on SQL Server.
+	sqlStr = ib.returningStatement.output(d, "inserted")
+	if sqlStr != "" {
+		queryParts = append(queryParts, sqlStr)
+	}
+
 	// Generate SQL string and arguments for the VALUES clause.
-	sqlStr, args = ib.rowStatement.StringArgs(args)
+	sqlStr, args = ib.rowStatement.renderArgs(d, args)
 	if sqlStr != "" {
 		queryParts = append(queryParts, sqlStr)
 	}
 
 	// Generate SQL string and arguments for the SUBQUERY clause.
-	sqlStr, args = ib.queryStatement.StringArgs(args)
+	sqlStr, args, err = ib.queryStatement.renderArgs(d, args)
+	if err != nil {
+		return "", args, err
+	}
+	if sqlStr != "" {
+		queryParts = append(queryParts, sqlStr)
+	}
+
+	// Generate SQL string and arguments for the upsert clause.
+	sqlStr, args, err = ib.conflictStatement.renderArgs(d, args)
+	if err != nil {
+		return "", args, err
+	}
+	if sqlStr != "" {
+		queryParts = append(queryParts, sqlStr)
+	}
+
+	// Generate SQL string for the RETURNING clause.
+	sqlStr, args, err = ib.returningStatement.renderArgs(d, args)
+	if err != nil {
+		return "", args, err
+	}
 	if sqlStr != "" {
 		queryParts = append(queryParts, sqlStr)
 	}
@@ -61,8 +178,12 @@ func (ib *InsertBuilder) StringArgs(args []any) (string, []any, error) {
 //   - string: The SQL INSERT statement for the table and columns.
 //   - []any: The updated slice of arguments.
 func (i *Insert) StringArgs(args []any) (string, []any) {
-	columnsStr := strings.Join(i.Columns, ", ")
-	return fmt.Sprintf("INSERT INTO %s (%s)", i.Table, columnsStr), args
+	return i.renderArgs(nil, args)
+}
+
+// renderArgs generates the SQL of StringArgs with the dialect d, the default dialect when nil.
+func (i *Insert) renderArgs(d Dialect, args []any) (string, []any) {
+	return i.render(d), args
 }
 
 // StringArgs generates the VALUES clause for the INSERT statement, including all rows.
@@ -74,12 +195,26 @@ func (i *Insert) StringArgs(args []any) (string, []any) {
 //   - string: The SQL VALUES clause.
 //   - []any: The updated slice of arguments.
 func (r *InsertRows) StringArgs(args []any) (string, []any) {
+	return r.renderArgs(nil, args)
+}
+
+// renderArgs generates the SQL of StringArgs with the dialect d, the default dialect when nil.
+func (r *InsertRows) renderArgs(d Dialect, args []any) (string, []any) {
 	var rowsStr []string
 	var sqlStr string
 
 	// Process each row in the VALUES clause.
 	for _, row := range r.Rows {
-		sqlStr, args = row.StringArgs(args)
+		if r.dual(d) {
+			var values []string
+
+			values, args = row.itemsArgs(d, args)
+			rowsStr = append(rowsStr, dualRow(values))
+
+			continue
+		}
+
+		sqlStr, args = row.renderArgs(d, args)
 		rowsStr = append(rowsStr, sqlStr)
 	}
 
@@ -88,6 +223,11 @@ func (r *InsertRows) StringArgs(args []any) (string, []any) {
 		return "", args
 	}
 
+	// Oracle selects several rows from DUAL.
+	if r.dual(d) {
+		return strings.Join(rowsStr, " UNION ALL "), args
+	}
+
 	return fmt.Sprintf("VALUES %s", strings.Join(rowsStr, ", ")), args
 }
 
@@ -100,6 +240,28 @@ func (r *InsertRows) StringArgs(args []any) (string, []any) {
 //   - string: The string representation of the row's values.
 //   - []any: The updated slice of arguments.
 func (ir *InsertRow) StringArgs(args []any) (string, []any) {
+	return ir.renderArgs(nil, args)
+}
+
+// renderArgs generates the SQL of StringArgs with the dialect d, the default dialect when nil.
+func (ir *InsertRow) renderArgs(d Dialect, args []any) (string, []any) {
+	var rowStr []string
+
+	rowStr, args = ir.itemsArgs(d, args)
+
+	return fmt.Sprintf("(%s)", strings.Join(rowStr, ", ")), args
+}
+
+// itemsArgs generates a placeholder for each value of the row and appends the values to the arguments slice.
+//
+// Parameters:
+//   - d: The dialect of the builder, the default dialect when nil.
+//   - args []any: A slice of arguments to be used in the statement.
+//
+// Returns:
+//   - []string: The placeholders, or the SQL of the ValueField values.
+//   - []any: The updated slice of arguments.
+func (ir *InsertRow) itemsArgs(d Dialect, args []any) ([]string, []any) {
 	var rowStr []string
 
 	// Process each value in the row.
@@ -108,16 +270,16 @@ func (ir *InsertRow) StringArgs(args []any) (string, []any) {
 			rowStr = append(rowStr, colField.Value())
 		} else if colString, ok := col.(string); ok {
 			args = append(args, colString)
-			colStr := p(args)
+			colStr := p(d, args)
 			rowStr = append(rowStr, colStr)
 		} else { // Value is of type int or float.
 			args = append(args, col)
-			colStr := p(args)
+			colStr := p(d, args)
 			rowStr = append(rowStr, colStr)
 		}
 	}
 
-	return fmt.Sprintf("(%s)", strings.Join(rowStr, ", ")), args
+	return rowStr, args
 }
 
 // StringArgs generates the SQL string for the subquery in the INSERT statement.
@@ -129,14 +291,74 @@ func (ir *InsertRow) StringArgs(args []any) (string, []any) {
 //   - string: The SQL string for the subquery.
 //   - []any: The updated slice of arguments.
 func (q *InsertQuery) StringArgs(args []any) (string, []any) {
-	if queryBuilder, ok := q.Query.(*QueryBuilder); ok {
-		var sqlStr string
+	sql, args, _ := q.renderArgs(nil, args)
+
+	return sql, args
+}
 
+// renderArgs generates the SQL of StringArgs with the dialect d, the default dialect when nil.
+func (q *InsertQuery) renderArgs(d Dialect, args []any) (string, []any, error) {
+	if queryBuilder, ok := q.Query.(*QueryBuilder); ok {
 		// Generate SQL string and arguments for the subquery.
-		sqlStr, args, _ = queryBuilder.StringArgs(args)
-		return sqlStr, args
+		return queryBuilder.renderArgs(d, args)
+	}
+
+	if compoundBuilder, ok := q.Query.(*CompoundBuilder); ok {
+		// Generate SQL string and arguments for the compound subquery.
+		return compoundBuilder.renderArgs(d, args)
 	}
 
 	// Return empty string if no subquery is specified.
-	return "", args
+	return "", args, nil
+}
+
+// StringArgs generates the upsert clause for the current dialect and appends
+// the values of the assignments and of the WHERE clause to the arguments slice.
+//
+// Parameters:
+//   - args []any: A slice of arguments to be used in the statement.
+//
+// Returns:
+//   - string: The upsert clause. Returns an empty string if no upsert is set.
+//   - []any: The updated slice of arguments.
+//   - error: ErrNotSupported when the dialect cannot express the upsert.
+func (c *OnConflict) StringArgs(args []any) (string, []any, error) {
+	return c.renderArgs(nil, args)
+}
+
+// renderArgs generates the SQL of StringArgs with the dialect d, the default dialect when nil.
+func (c *OnConflict) renderArgs(d Dialect, args []any) (string, []any, error) {
+	if c.Action == ConflictNone {
+		return "", args, nil
+	}
+
+	var assignments []string
+	var sqlStr string
+	var err error
+
+	// Generate the assignments of DO UPDATE.
+	for _, item := range c.Set.Items {
+		item = c.resolve(d, item)
+
+		sqlStr, args, err = item.renderArgs(d, args)
+		if err != nil {
+			return "", args, err
+		}
+
+		assignments = append(assignments, sqlStr)
+	}
+
+	// Generate the WHERE clause of DO UPDATE.
+	var where string
+
+	resolved := Where{Conditions: c.resolveConditions(d, c.Where.Conditions)}
+
+	where, args, err = resolved.renderArgs(d, args)
+	if err != nil {
+		return "", args, err
+	}
+
+	sqlStr, err = c.build(d, assignments, where)
+
+	return sqlStr, args, err
 }
@@ -1,6 +1,7 @@
 package fluentsql
 
 import (
+	"errors"
 	"testing"
 )
 
@@ -52,3 +53,309 @@ func TestInsertTableArgs(t *testing.T) {
 		}
 	}
 }
+
+// TestInsertWith tests the WITH clause of InsertBuilder
+func TestInsertWith(t *testing.T) {
+	query := InsertInstance().
+		With("archived", QueryInstance().
+			Select("id", "name").
+			From("products").
+			Where("archived", Eq, true),
+		).
+		Insert("products_archive", "id", "name").
+		Query(QueryInstance().Select("id", "name").From("archived").Where("name", NotEq, "draft"))
+
+	sql, args, _ := query.Sql()
+
+	expected := "WITH archived AS (SELECT id, name FROM products WHERE archived = $1) INSERT INTO products_archive (id, name) SELECT id, name FROM archived WHERE name <> $2"
+	if sql != expected {
+		t.Fatalf(`Query %s != %s (%v)`, sql, expected, args)
+	}
+
+	if len(args) != 2 || args[0] != true || args[1] != "draft" {
+		t.Fatalf("Unexpected arguments %v", args)
+	}
+}
+
+// TestInsertUpsert tests the ON CONFLICT / ON DUPLICATE KEY UPDATE clause of InsertBuilder
+func TestInsertUpsert(t *testing.T) {
+	originalDialect := defaultDialect
+	defer func() {
+		defaultDialect = originalDialect
+	}()
+
+	query := InsertInstance().
+		Insert("users", "email", "name").
+		Row("ann@example.com", "Ann").
+		OnConflict("email").
+		DoUpdate("name", Excluded("name")).
+		DoUpdate("visits", 0).
+		DoUpdateWhere("active", Eq, true)
+
+	expected := "INSERT INTO users (email, name) VALUES ('ann@example.com', 'Ann') ON CONFLICT (email) DO UPDATE SET name = EXCLUDED.name, visits = 0 WHERE active = true"
+	if query.String() != expected {
+		t.Fatalf(`Query %s != %s`, query.String(), expected)
+	}
+
+	sql, args, err := query.Sql()
+
+	expected = "INSERT INTO users (email, name) VALUES ($1, $2) ON CONFLICT (email) DO UPDATE SET name = EXCLUDED.name, visits = $3 WHERE active = $4"
+	if err != nil || sql != expected {
+		t.Fatalf(`Query %s != %s (%v)`, sql, expected, err)
+	}
+
+	if len(args) != 4 || args[2] != 0 || args[3] != true {
+		t.Fatalf("Unexpected arguments %v", args)
+	}
+
+	sql, _, _ = InsertInstance().
+		Insert("users", "email", "name").
+		Row("ann@example.com", "Ann").
+		OnConflictConstraint("users_email_key").
+		Sql()
+
+	expected = "INSERT INTO users (email, name) VALUES ($1, $2) ON CONFLICT ON CONSTRAINT users_email_key DO NOTHING"
+	if sql != expected {
+		t.Fatalf(`Query %s != %s`, sql, expected)
+	}
+
+	SetDialect(new(MySQLDialect))
+
+	sql, args, err = InsertInstance().
+		Insert("users", "email", "name").
+		Row("ann@example.com", "Ann").
+		OnDuplicateKeyUpdate("name", Excluded("name")).
+		OnDuplicateKeyUpdate("visits", 0).
+		Sql()
+
+	expected = "INSERT INTO users (email, name) VALUES (?, ?) ON DUPLICATE KEY UPDATE name = VALUES(name), visits = ?"
+	if err != nil || sql != expected {
+		t.Fatalf(`Query %s != %s (%v)`, sql, expected, err)
+	}
+
+	if len(args) != 3 || args[2] != 0 {
+		t.Fatalf("Unexpected arguments %v", args)
+	}
+
+	sql, _, err = InsertInstance().
+		Insert("users", "email", "name").
+		Row("ann@example.com", "Ann").
+		RowAlias("new").
+		OnDuplicateKeyUpdate("name", Excluded("name")).
+		Sql()
+
+	expected = "INSERT INTO users (email, name) VALUES (?, ?) AS new ON DUPLICATE KEY UPDATE name = new.name"
+	if err != nil || sql != expected {
+		t.Fatalf(`Query %s != %s (%v)`, sql, expected, err)
+	}
+
+	_, _, err = InsertInstance().
+		Insert("users", "email", "name").
+		Row("ann@example.com", "Ann").
+		OnConflict("email").
+		DoUpdate("name", Excluded("name")).
+		DoUpdateWhere("active", Eq, true).
+		Sql()
+
+	if !errors.Is(err, ErrNotSupported) {
+		t.Fatalf("Expected ErrNotSupported, got %v", err)
+	}
+}
+
+// TestInsertUpsertExpr tests the Excluded operands of the upsert expressions
+func TestInsertUpsertExpr(t *testing.T) {
+	upsert := func(dialect Dialect, alias string) *InsertBuilder {
+		return InsertInstance().
+			SetDialect(dialect).
+			Insert("counters", "id", "n").
+			Row(1, 1).
+			RowAlias(alias).
+			OnConflict("id").
+			DoUpdate("n", Add(Ident("n"), Excluded("n"))).
+			DoUpdate("total", Coalesce(Mul(Excluded("n"), 2), 0))
+	}
+
+	testCases := []struct {
+		query    *InsertBuilder
+		expected string
+	}{
+		{
+			upsert(new(PostgreSQLDialect), ""),
+			`INSERT INTO counters (id, n) VALUES ($1, $2) ON CONFLICT (id) DO UPDATE SET n = "n" + EXCLUDED.n, ` +
+				"total = COALESCE(EXCLUDED.n * $3, $4)",
+		},
+		{
+			upsert(new(MySQLDialect), ""),
+			"INSERT INTO counters (id, n) VALUES (?, ?) ON DUPLICATE KEY UPDATE n = `n` + VALUES(n), " +
+				"total = COALESCE(VALUES(n) * ?, ?)",
+		},
+		{
+			upsert(new(MySQLDialect), "new"),
+			"INSERT INTO counters (id, n) VALUES (?, ?) AS new ON DUPLICATE KEY UPDATE n = `n` + new.n, " +
+				"total = COALESCE(new.n * ?, ?)",
+		},
+	}
+
+	for _, testCase := range testCases {
+		sql, _, err := testCase.query.Sql()
+		if err != nil || sql != testCase.expected {
+			t.Fatalf(`Query %s != %s (%v)`, sql, testCase.expected, err)
+		}
+	}
+
+	// DO UPDATE requires a conflict target on PostgreSQL and SQLite
+	for _, dialect := range []Dialect{new(PostgreSQLDialect), new(SQLiteDialect)} {
+		_, _, err := InsertInstance().
+			SetDialect(dialect).
+			Insert("users", "email", "name").
+			Row("ann@example.com", "Ann").
+			OnConflict().
+			DoUpdate("name", Excluded("name")).
+			Sql()
+		if !errors.Is(err, ErrNotSupported) {
+			t.Fatalf("Expected ErrNotSupported for %s, got %v", dialect.Name(), err)
+		}
+	}
+}
+
+// TestInsertUpsertResolve tests the Excluded operands of the WHERE clause, raw fragments, aggregates and CASE conditions
+func TestInsertUpsertResolve(t *testing.T) {
+	originalDialect := defaultDialect
+	defer func() {
+		defaultDialect = originalDialect
+	}()
+
+	// The WHERE clause of DO UPDATE uses the dialect of the builder, not the default one
+	SetDialect(new(MySQLDialect))
+
+	sql, _, err := InsertInstance().
+		SetDialect(new(PostgreSQLDialect)).
+		Insert("counters", "id", "n").
+		Row(1, 1).
+		OnConflict("id").
+		DoUpdate("n", Excluded("n")).
+		DoUpdateWhere("n", Lesser, Excluded("n")).
+		Sql()
+
+	expected := "INSERT INTO counters (id, n) VALUES ($1, $2) ON CONFLICT (id) DO UPDATE SET n = EXCLUDED.n WHERE n < EXCLUDED.n"
+	if err != nil || sql != expected {
+		t.Fatalf(`Query %s != %s (%v)`, sql, expected, err)
+	}
+
+	query := InsertInstance().
+		Insert("counters", "id", "n").
+		Row(1, 1).
+		OnDuplicateKeyUpdate("n", Raw("GREATEST(n, ?)", Excluded("n"))).
+		OnDuplicateKeyUpdate("total", new(Case).When(Condition{Field: Excluded("n"), Opt: Greater, Value: 0}, Excluded("n")).Else(0))
+
+	sql, args, err := query.Sql()
+
+	expected = "INSERT INTO counters (id, n) VALUES (?, ?) ON DUPLICATE KEY UPDATE n = GREATEST(n, VALUES(n)), " +
+		"total = CASE WHEN VALUES(n) > ? THEN VALUES(n) ELSE ? END"
+	if err != nil || sql != expected {
+		t.Fatalf(`Query %s != %s (%v)`, sql, expected, err)
+	}
+
+	if len(args) != 4 {
+		t.Fatalf("Unexpected arguments %v", args)
+	}
+
+	sql, _, err = InsertInstance().
+		SetDialect(new(SQLiteDialect)).
+		Insert("totals", "id", "amount").
+		Row(1, 10).
+		OnConflict("id").
+		DoUpdate("amount", Add(Ident("amount"), Coalesce(Agg("ABS", Excluded("amount")), 0))).
+		Sql()
+
+	expected = `INSERT INTO totals (id, amount) VALUES (?, ?) ON CONFLICT (id) DO UPDATE SET amount = "amount" + COALESCE(ABS(EXCLUDED.amount), ?)`
+	if err != nil || sql != expected {
+		t.Fatalf(`Query %s != %s (%v)`, sql, expected, err)
+	}
+}
+
+// TestInsertReturning tests the RETURNING clause of InsertBuilder
+func TestInsertReturning(t *testing.T) {
+	originalDialect := defaultDialect
+	defer func() {
+		defaultDialect = originalDialect
+	}()
+
+	query := InsertInstance().
+		Insert("users", "email", "name").
+		Row("ann@example.com", "Ann").
+		OnConflict("email").
+		DoNothing().
+		Returning("id", "created_at")
+
+	sql, args, err := query.Sql()
+
+	expected := "INSERT INTO users (email, name) VALUES ($1, $2) ON CONFLICT (email) DO NOTHING RETURNING id, created_at"
+	if err != nil || sql != expected {
+		t.Fatalf(`Query %s != %s (%v)`, sql, expected, err)
+	}
+
+	if len(args) != 2 {
+		t.Fatalf("Unexpected arguments %v", args)
+	}
+
+	SetDialect(new(MySQLDialect))
+
+	if _, _, err = InsertInstance().Insert("users", "name").Row("Ann").Returning("*").Sql(); !errors.Is(err, ErrNotSupported) {
+		t.Fatalf("Expected ErrNotSupported, got %v", err)
+	}
+}
+
+// TestInsertStruct tests the rows built from tagged structs
+func TestInsertStruct(t *testing.T) {
+	email := "ann@example.com"
+
+	query := InsertInstance().
+		Insert("users").
+		Struct(&structUser{ID: 1, Name: "Ann", Email: &email, Age: 30}, StructOptions{SkipPrimaryKey: true, Exclude: []string{"created_at"}})
+
+	expected := "INSERT INTO users (name, email, age) VALUES ('Ann', 'ann@example.com', 30)"
+	if query.String() != expected {
+		t.Fatalf(`Query %s != %s`, query.String(), expected)
+	}
+
+	query = InsertInstance().
+		Insert("countries").
+		Structs([]structCountry{{ID: "VN", Name: "Vietnam"}, {ID: "FR", Name: "France"}})
+
+	sql, args, _ := query.Sql()
+
+	expected = "INSERT INTO countries (id, name) VALUES ($1, $2), ($3, $4)"
+	if sql != expected {
+		t.Fatalf(`Query %s != %s (%v)`, sql, expected, args)
+	}
+
+	if len(args) != 4 || args[2] != "FR" || args[3] != "France" {
+		t.Fatalf("Unexpected arguments %v", args)
+	}
+
+	// Explicit columns take precedence over the struct fields
+	query = InsertInstance().
+		Insert("countries", "name").
+		Structs([]*structCountry{{ID: "VN", Name: "Vietnam"}})
+
+	expected = "INSERT INTO countries (name) VALUES ('Vietnam')"
+	if query.String() != expected {
+		t.Fatalf(`Query %s != %s`, query.String(), expected)
+	}
+
+	// A column without struct field, or no column left by the options
+	for _, query := range []*InsertBuilder{
+		InsertInstance().Insert("countries", "name", "code").Struct(structCountry{ID: "VN", Name: "Vietnam"}),
+		InsertInstance().Insert("countries").Struct(structCountry{}, StructOptions{OmitZero: true}),
+	} {
+		if _, _, err := query.Sql(); !errors.Is(err, ErrStructColumns) {
+			t.Fatalf("Expected ErrStructColumns, got %v", err)
+		}
+	}
+
+	// Rows without table
+	if _, _, err := InsertInstance().Struct(structCountry{ID: "VN", Name: "Vietnam"}).Sql(); !errors.Is(err, ErrMissingTable) {
+		t.Fatalf("Expected ErrMissingTable, got %v", err)
+	}
+}
@@ -1,6 +1,7 @@
 package fluentsql
 
 import (
+	"errors"
 	"testing"
 )
 
@@ -26,6 +27,9 @@ func TestInsertRow(t *testing.T) {
 		"(first_name, 'last_name', 12, 92.3)": {
 			Values: []any{ValueField("first_name"), "last_name", 12, 92.3},
 		},
+		"('last_name', NULL)": {
+			Values: []any{"last_name", nil},
+		},
 	}
 
 	for expected, table := range testCases {
@@ -51,3 +55,90 @@ func TestInsertRows(t *testing.T) {
 		}
 	}
 }
+
+// TestInsertOnConflict
+func TestInsertOnConflict(t *testing.T) {
+	testCases := map[string]OnConflict{
+		"":                               {},
+		"ON CONFLICT DO NOTHING":         {Action: ConflictDoNothing},
+		"ON CONFLICT (email) DO NOTHING": {Columns: []string{"email"}, Action: ConflictDoNothing},
+		"ON CONFLICT ON CONSTRAINT users_pk DO NOTHING": {Constraint: "users_pk", Action: ConflictDoNothing},
+		"ON CONFLICT (email) DO UPDATE SET name = EXCLUDED.name, visits = 1": {
+			Columns: []string{"email"},
+			Action:  ConflictDoUpdate,
+			Set: UpdateSet{Items: []UpdateItem{
+				{Field: "name", Value: Excluded("name")},
+				{Field: "visits", Value: 1},
+			}},
+		},
+		"ON CONFLICT (email) DO UPDATE SET name = EXCLUDED.name WHERE active = true": {
+			Columns: []string{"email"},
+			Action:  ConflictDoUpdate,
+			Set:     UpdateSet{Items: []UpdateItem{{Field: "name", Value: Excluded("name")}}},
+			Where:   Where{Conditions: []Condition{{Field: "active", Opt: Eq, Value: true, AndOr: And}}},
+		},
+	}
+
+	for expected, conflict := range testCases {
+		if conflict.String() != expected {
+			t.Fatalf(`Query %s != %s`, conflict.String(), expected)
+		}
+	}
+}
+
+// TestInsertOnConflictDialect
+func TestInsertOnConflictDialect(t *testing.T) {
+	originalDialect := defaultDialect
+	defer func() {
+		defaultDialect = originalDialect
+	}()
+
+	SetDialect(new(MySQLDialect))
+
+	conflict := OnConflict{
+		Columns: []string{"email"},
+		Action:  ConflictDoUpdate,
+		Set:     UpdateSet{Items: []UpdateItem{{Field: "name", Value: Excluded("name")}}},
+	}
+	if conflict.String() != "ON DUPLICATE KEY UPDATE name = VALUES(name)" {
+		t.Fatalf("Expected ON DUPLICATE KEY UPDATE name = VALUES(name), got %s", conflict.String())
+	}
+
+	conflict.Alias = "new"
+	if conflict.String() != "AS new ON DUPLICATE KEY UPDATE name = new.name" {
+		t.Fatalf("Expected AS new ON DUPLICATE KEY UPDATE name = new.name, got %s", conflict.String())
+	}
+
+	// MySQL has no DO NOTHING, the first conflict column is assigned to itself
+	conflict = OnConflict{Columns: []string{"email"}, Action: ConflictDoNothing}
+	if conflict.String() != "ON DUPLICATE KEY UPDATE email = email" {
+		t.Fatalf("Expected ON DUPLICATE KEY UPDATE email = email, got %s", conflict.String())
+	}
+
+	conflict = OnConflict{Action: ConflictDoNothing}
+	if _, _, err := conflict.StringArgs(nil); !errors.Is(err, ErrNotSupported) {
+		t.Fatalf("Expected ErrNotSupported, got %v", err)
+	}
+
+	conflict = OnConflict{
+		Action: ConflictDoUpdate,
+		Set:    UpdateSet{Items: []UpdateItem{{Field: "name", Value: Excluded("name")}}},
+		Where:  Where{Conditions: []Condition{{Field: "active", Opt: Eq, Value: true, AndOr: And}}},
+	}
+	if _, _, err := conflict.StringArgs(nil); !errors.Is(err, ErrNotSupported) {
+		t.Fatalf("Expected ErrNotSupported, got %v", err)
+	}
+
+	// SQLite has no named conflict target
+	SetDialect(new(SQLiteDialect))
+
+	conflict = OnConflict{Constraint: "users_pk", Action: ConflictDoNothing}
+	if _, _, err := conflict.StringArgs(nil); !errors.Is(err, ErrNotSupported) {
+		t.Fatalf("Expected ErrNotSupported, got %v", err)
+	}
+
+	conflict = OnConflict{Columns: []string{"email"}, Action: ConflictDoNothing, Alias: "new"}
+	if _, _, err := conflict.StringArgs(nil); !errors.Is(err, ErrNotSupported) {
+		t.Fatalf("Expected ErrNotSupported, got %v", err)
+	}
+}
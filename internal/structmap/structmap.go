@@ -0,0 +1,312 @@
+// Package structmap maps the fields of Go structs to SQL columns using `db` struct tags.
+//
+// Tag syntax:
+//
+//	Name  string `db:"name"`     // column "name"
+//	Notes string `db:"-"`        // ignored
+//	Email string                 // column "email" (snake case of the field name)
+//
+// Embedded structs without a tag are flattened into the parent, like Go promotes their fields.
+// The metadata of every struct type is computed once and cached.
+package structmap
+
+import (
+	"database/sql"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+)
+
+// TagName is the struct tag holding the column name.
+const TagName = "db"
+
+// Field describes a struct field mapped to a column.
+type Field struct {
+	Name    string       // Name is the column name.
+	Index   []int        // Index is the index sequence of the field for reflect.Value.FieldByIndex.
+	Type    reflect.Type // Type is the type of the field.
+	Options []string     // Options holds the tag options following the column name, e.g. "pk".
+}
+
+// HasOption reports whether the tag of the field contains the option.
+//
+// Parameters:
+//   - option: The tag option, e.g. "pk".
+//
+// Returns:
+//   - bool: true if the option is set.
+func (f *Field) HasOption(option string) bool {
+	for _, opt := range f.Options {
+		if opt == option {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Struct describes the columns of a struct type.
+type Struct struct {
+	Type   reflect.Type      // Type is the struct type.
+	Fields []*Field          // Fields holds the mapped fields in declaration order.
+	names  map[string]*Field // names indexes the fields by column name.
+	folded map[string]*Field // folded indexes the fields by normalized column name.
+}
+
+// cache holds the *Struct of every struct type already mapped.
+var cache sync.Map
+
+// Of returns the column mapping of a struct type, computing it on first use.
+//
+// Parameters:
+//   - t: A struct type or a pointer to a struct type.
+//
+// Returns:
+//   - *Struct: The column mapping. Returns nil if t is not a struct.
+func Of(t reflect.Type) *Struct {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+
+	if s, ok := cache.Load(t); ok {
+		return s.(*Struct)
+	}
+
+	s := &Struct{
+		Type:   t,
+		names:  map[string]*Field{},
+		folded: map[string]*Field{},
+	}
+	s.collect(t)
+
+	actual, _ := cache.LoadOrStore(t, s)
+
+	return actual.(*Struct)
+}
+
+// IsScalar reports whether values of the type are scanned as a single column
+// instead of being mapped field by field.
+//
+// Parameters:
+//   - t: The type to check.
+//
+// Returns:
+//   - bool: true for non-struct types, time.Time and types implementing sql.Scanner.
+func IsScalar(t reflect.Type) bool {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	if t.Kind() != reflect.Struct || t == reflect.TypeOf(time.Time{}) {
+		return true
+	}
+
+	return reflect.PointerTo(t).Implements(reflect.TypeOf((*sql.Scanner)(nil)).Elem())
+}
+
+// collect adds the mapped fields of a struct type, flattening the embedded structs.
+// The structs are walked level by level, so the fields of a shallower depth shadow
+// the fields of the embedded structs, as in Go.
+//
+// Parameters:
+//   - t: The struct type.
+func (s *Struct) collect(t reflect.Type) {
+	type level struct {
+		t     reflect.Type
+		index []int
+	}
+
+	visited := map[reflect.Type]bool{t: true}
+	current := []level{{t: t}}
+
+	for len(current) > 0 {
+		var next []level
+
+		for _, lv := range current {
+			for i := 0; i < lv.t.NumField(); i++ {
+				sf := lv.t.Field(i)
+				index := append(append([]int{}, lv.index...), i)
+
+				tag, hasTag := sf.Tag.Lookup(TagName)
+				if tag == "-" {
+					continue
+				}
+
+				name, options, _ := strings.Cut(tag, ",")
+
+				// Embedded structs without a column name are flattened at the next level.
+				if sf.Anonymous && name == "" && !IsScalar(sf.Type) {
+					ft := sf.Type
+					if ft.Kind() == reflect.Pointer {
+						// A nil pointer to an unexported struct cannot be allocated through reflection.
+						if !sf.IsExported() {
+							continue
+						}
+
+						ft = ft.Elem()
+					}
+
+					if !visited[ft] {
+						visited[ft] = true
+						next = append(next, level{t: ft, index: index})
+					}
+
+					continue
+				}
+
+				if !sf.IsExported() {
+					continue
+				}
+
+				if !hasTag || name == "" {
+					name = SnakeCase(sf.Name)
+				}
+
+				field := &Field{
+					Name:  name,
+					Index: index,
+					Type:  sf.Type,
+				}
+
+				if options != "" {
+					field.Options = strings.Split(options, ",")
+				}
+
+				s.add(field)
+			}
+		}
+
+		current = next
+	}
+}
+
+// add registers a field unless a field with the same column name was registered at a shallower depth.
+//
+// Parameters:
+//   - field: The field to register.
+func (s *Struct) add(field *Field) {
+	if _, ok := s.names[field.Name]; ok {
+		return
+	}
+
+	s.Fields = append(s.Fields, field)
+	s.names[field.Name] = field
+
+	if _, ok := s.folded[fold(field.Name)]; !ok {
+		s.folded[fold(field.Name)] = field
+	}
+}
+
+// Lookup finds the field mapped to a column.
+// The exact column name is tried first, then the name ignoring case and underscores,
+// so a "UserName" or "username" column still matches the "user_name" field.
+//
+// Parameters:
+//   - column: The column name.
+//
+// Returns:
+//   - *Field: The field mapped to the column.
+//   - bool: false if no field matches.
+func (s *Struct) Lookup(column string) (*Field, bool) {
+	if field, ok := s.names[column]; ok {
+		return field, true
+	}
+
+	field, ok := s.folded[fold(column)]
+
+	return field, ok
+}
+
+// Target returns the field of a struct value for writing,
+// allocating the nil pointers of the embedded structs on the way.
+//
+// Parameters:
+//   - v: An addressable struct value.
+//
+// Returns:
+//   - reflect.Value: The settable field.
+func (f *Field) Target(v reflect.Value) reflect.Value {
+	for i, x := range f.Index {
+		if i > 0 && v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+
+			v = v.Elem()
+		}
+
+		v = v.Field(x)
+	}
+
+	return v
+}
+
+// Value returns the field of a struct value for reading.
+//
+// Parameters:
+//   - v: A struct value.
+//
+// Returns:
+//   - reflect.Value: The field.
+//   - bool: false if an embedded struct on the way is a nil pointer.
+func (f *Field) Value(v reflect.Value) (reflect.Value, bool) {
+	for i, x := range f.Index {
+		if i > 0 && v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				return reflect.Value{}, false
+			}
+
+			v = v.Elem()
+		}
+
+		v = v.Field(x)
+	}
+
+	return v, true
+}
+
+// SnakeCase converts a Go field name to a column name, e.g. "UserID" to "user_id".
+//
+// Parameters:
+//   - name: The field name.
+//
+// Returns:
+//   - string: The snake case name.
+func SnakeCase(name string) string {
+	runes := []rune(name)
+
+	var sb strings.Builder
+
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			// Start a new word on a lower-to-upper change or at the last capital of an acronym.
+			if i > 0 && runes[i-1] != '_' && (unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1]) ||
+				(i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+				sb.WriteByte('_')
+			}
+
+			r = unicode.ToLower(r)
+		}
+
+		sb.WriteRune(r)
+	}
+
+	return sb.String()
+}
+
+// fold normalizes a column name for the tolerant lookup.
+//
+// Parameters:
+//   - name: The column name.
+//
+// Returns:
+//   - string: The name in lower case without underscores.
+func fold(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, "_", ""))
+}
@@ -0,0 +1,121 @@
+package structmap
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type audit struct {
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+	Version   int       `db:"version"`
+}
+
+type Owner struct {
+	OwnerID int `db:"owner_id"`
+}
+
+type hidden struct {
+	HiddenID int `db:"hidden_id"`
+}
+
+type account struct {
+	audit
+	*Owner
+	*hidden
+	ID       int            `db:"id,pk"`
+	UserName string         // user_name
+	Email    sql.NullString `db:"email"`
+	Version  string         `db:"version"` // shadows audit.Version
+	Password string         `db:"-"`
+	secret   string
+}
+
+// TestOf
+func TestOf(t *testing.T) {
+	s := Of(reflect.TypeOf(&account{}))
+
+	var names []string
+	for _, field := range s.Fields {
+		names = append(names, field.Name)
+	}
+
+	expected := []string{"id", "user_name", "email", "version", "created_at", "updated_at", "owner_id"}
+	if !reflect.DeepEqual(names, expected) {
+		t.Fatalf("Fields %v != %v", names, expected)
+	}
+
+	if Of(reflect.TypeOf(account{})) != s {
+		t.Fatal("Expected the cached mapping")
+	}
+
+	if Of(reflect.TypeOf(0)) != nil {
+		t.Fatal("Expected nil for a non-struct type")
+	}
+
+	id, _ := s.Lookup("id")
+	if !id.HasOption("pk") {
+		t.Fatal("Expected the pk option on id")
+	}
+}
+
+// TestLookup
+func TestLookup(t *testing.T) {
+	s := Of(reflect.TypeOf(account{}))
+
+	testCases := map[string]string{
+		"user_name": "user_name",
+		"UserName":  "user_name",
+		"username":  "user_name",
+		"OWNER_ID":  "owner_id",
+	}
+
+	for column, expected := range testCases {
+		field, ok := s.Lookup(column)
+		if !ok || field.Name != expected {
+			t.Fatalf("Lookup %s != %s", column, expected)
+		}
+	}
+
+	if _, ok := s.Lookup("password"); ok {
+		t.Fatal("Expected no field for an ignored column")
+	}
+}
+
+// TestTarget
+func TestTarget(t *testing.T) {
+	s := Of(reflect.TypeOf(account{}))
+	v := reflect.ValueOf(&account{}).Elem()
+
+	field, _ := s.Lookup("owner_id")
+
+	if _, ok := field.Value(v); ok {
+		t.Fatal("Expected no value behind a nil embedded pointer")
+	}
+
+	field.Target(v).SetInt(7)
+
+	if value, ok := field.Value(v); !ok || value.Int() != 7 {
+		t.Fatalf("Unexpected value %v", value)
+	}
+}
+
+// TestSnakeCase
+func TestSnakeCase(t *testing.T) {
+	testCases := map[string]string{
+		"ID":        "id",
+		"UserID":    "user_id",
+		"HTTPCode":  "http_code",
+		"FirstName": "first_name",
+		"Address2":  "address2",
+		"User_Id":   "user_id",
+	}
+
+	for name, expected := range testCases {
+		if SnakeCase(name) != expected {
+			t.Fatalf("SnakeCase %s != %s", SnakeCase(name), expected)
+		}
+	}
+}
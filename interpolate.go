@@ -0,0 +1,185 @@
+package fluentsql
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// timeLayout is the layout of the time literals, understood by every dialect. The literals have no zone,
+// times are converted to UTC first.
+const timeLayout = "2006-01-02 15:04:05.999999"
+
+// literal generates the SQL literal of a value for the dialect d.
+// Strings and bytes are quoted and escaped by the dialect, times are quoted in UTC, bools are written as true/false,
+// or 1/0 on SQL Server and Oracle, slices are written as PostgreSQL arrays. Other values, such as pointers, named types and driver.Valuer,
+// are converted with driver.DefaultParameterConverter first.
+//
+// Parameters:
+//   - d: The dialect of the builder, the default dialect when nil.
+//   - value: The value to inline.
+//
+// Returns:
+//   - string: The SQL literal, e.g. 'Ann' or NULL.
+//   - error: An error when the value cannot be converted to a driver value, ErrMissingParam for a Param.
+func literal(d Dialect, value any) (string, error) {
+	d = dialectOr(d)
+
+	switch v := value.(type) {
+	case nil:
+		return "NULL", nil
+	case Param:
+		return "", fmt.Errorf("%w: %q", ErrMissingParam, string(v))
+	case string:
+		return d.QuoteString(v), nil
+	case []byte:
+		if v == nil {
+			return "NULL", nil
+		}
+
+		return d.QuoteBytes(v), nil
+	case time.Time:
+		v = v.UTC()
+
+		if isDialect(d, Oracle) {
+			return "TIMESTAMP " + d.QuoteString(v.Format(timeLayout)), nil
+		}
+
+		return d.QuoteString(v.Format(timeLayout)), nil
+	case bool:
+		if isDialect(d, SQLServer) || isDialect(d, Oracle) {
+			if v {
+				return "1", nil
+			}
+
+			return "0", nil
+		}
+
+		return strconv.FormatBool(v), nil
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		return fmt.Sprintf("%v", v), nil
+	}
+
+	// PostgreSQL arrays, e.g. the list of an IN condition bound as one array parameter.
+	if list := reflect.ValueOf(value); isDialect(d, PostgreSQL) && (list.Kind() == reflect.Slice || list.Kind() == reflect.Array) {
+		var items []string
+		for i := 0; i < list.Len(); i++ {
+			item, err := literal(d, list.Index(i).Interface())
+			if err != nil {
+				return "", err
+			}
+
+			items = append(items, item)
+		}
+
+		return fmt.Sprintf("ARRAY[%s]", strings.Join(items, ", ")), nil
+	}
+
+	converted, err := driver.DefaultParameterConverter.ConvertValue(value)
+	if err != nil {
+		return "", err
+	}
+
+	return literal(d, converted)
+}
+
+// inline generates the SQL literal of a value for the String methods, which cannot return an error.
+// A value that cannot be converted is written with %v.
+//
+// Parameters:
+//   - d: The dialect of the builder, the default dialect when nil.
+//   - value: The value to inline.
+//
+// Returns:
+//   - string: The SQL literal.
+func inline(d Dialect, value any) string {
+	sql, err := literal(d, value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+
+	return sql
+}
+
+// interpolate replaces the placeholders of a statement generated by Sql with the literals of their arguments.
+// Quoted strings and identifiers are copied as is, so a placeholder-like text inside them is not replaced.
+//
+// Parameters:
+//   - d: The dialect the statement was generated with, the default dialect when nil.
+//   - sql: The statement with placeholders.
+//   - args: The arguments of the placeholders.
+//
+// Returns:
+//   - string: The statement with inlined arguments.
+//   - error: An error when a placeholder has no argument or an argument cannot be inlined.
+func interpolate(d Dialect, sql string, args []any) (string, error) {
+	d = dialectOr(d)
+
+	// Numbered placeholders are the prefix of the first placeholder followed by the position, e.g. $1 or @p1.
+	prefix := d.Placeholder(1)
+	numbered := strings.HasSuffix(prefix, "1")
+	prefix = strings.TrimSuffix(prefix, "1")
+
+	var sb strings.Builder
+	position := 0
+
+	for i := 0; i < len(sql); i++ {
+		c := sql[i]
+
+		// Copy quoted strings and identifiers.
+		if c == '\'' || c == '"' || c == '`' {
+			end := strings.IndexByte(sql[i+1:], c)
+			if end < 0 {
+				sb.WriteString(sql[i:])
+				break
+			}
+
+			sb.WriteString(sql[i : i+end+2])
+			i += end + 1
+
+			continue
+		}
+
+		if !strings.HasPrefix(sql[i:], prefix) {
+			sb.WriteByte(c)
+			continue
+		}
+
+		index := position
+		next := i + len(prefix)
+
+		if numbered {
+			digits := next
+			for digits < len(sql) && sql[digits] >= '0' && sql[digits] <= '9' {
+				digits++
+			}
+
+			if digits == next {
+				sb.WriteByte(c)
+				continue
+			}
+
+			index, _ = strconv.Atoi(sql[next:digits])
+			index--
+			next = digits
+		}
+
+		if index < 0 || index >= len(args) {
+			return "", fmt.Errorf("fluentsql: missing argument for placeholder %s", sql[i:next])
+		}
+
+		value, err := literal(d, args[index])
+		if err != nil {
+			return "", err
+		}
+
+		sb.WriteString(value)
+		position++
+		i = next - 1
+	}
+
+	return sb.String(), nil
+}
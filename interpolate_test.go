@@ -0,0 +1,150 @@
+package fluentsql
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+)
+
+// TestLiteral
+func TestLiteral(t *testing.T) {
+	hired := time.Date(1999, 4, 1, 9, 30, 0, 0, time.UTC)
+	name := "Ann"
+
+	testCases := []struct {
+		dialect  Dialect
+		value    any
+		expected string
+	}{
+		{new(PostgreSQLDialect), "O'Brien", `'O''Brien'`},
+		{new(PostgreSQLDialect), `C:\temp`, `'C:\temp'`},
+		{new(MySQLDialect), `\' OR 1=1 --`, `'\\'' OR 1=1 --'`},
+		{new(ClickHouseDialect), `it's`, `'it''s'`},
+		{new(PostgreSQLDialect), nil, "NULL"},
+		{new(PostgreSQLDialect), 42, "42"},
+		{new(PostgreSQLDialect), 1.5, "1.5"},
+		{new(PostgreSQLDialect), true, "true"},
+		{new(SQLServerDialect), true, "1"},
+		{new(OracleDialect), false, "0"},
+		{new(PostgreSQLDialect), []byte{0xca, 0xfe}, `'\xcafe'::bytea`},
+		{new(MySQLDialect), []byte{0xca, 0xfe}, "X'cafe'"},
+		{new(SQLServerDialect), []byte{0xca, 0xfe}, "0xcafe"},
+		{new(OracleDialect), []byte{0xca, 0xfe}, "HEXTORAW('cafe')"},
+		{new(PostgreSQLDialect), hired, "'1999-04-01 09:30:00'"},
+		{new(OracleDialect), hired, "TIMESTAMP '1999-04-01 09:30:00'"},
+		{new(PostgreSQLDialect), hired.In(time.FixedZone("ICT", 7*60*60)), "'1999-04-01 09:30:00'"},
+		{new(PostgreSQLDialect), &name, "'Ann'"},
+		{new(PostgreSQLDialect), sql.NullString{String: "Ann", Valid: true}, "'Ann'"},
+		{new(PostgreSQLDialect), sql.NullInt64{}, "NULL"},
+	}
+
+	for _, testCase := range testCases {
+		value, err := literal(testCase.dialect, testCase.value)
+		if err != nil || value != testCase.expected {
+			t.Fatalf(`Query %s != %s (%v)`, value, testCase.expected, err)
+		}
+	}
+
+	if _, err := literal(nil, struct{}{}); err == nil {
+		t.Fatalf("Expected an error for an unsupported value")
+	}
+}
+
+// TestInterpolate
+func TestInterpolate(t *testing.T) {
+	testCases := []struct {
+		dialect  Dialect
+		sql      string
+		args     []any
+		expected string
+	}{
+		{new(MySQLDialect), "SELECT * FROM users WHERE name = ? AND id > ?", []any{"O'Brien", 3},
+			"SELECT * FROM users WHERE name = 'O''Brien' AND id > 3"},
+		{new(PostgreSQLDialect), "SELECT * FROM users WHERE a = $2 AND b = $1 AND c = $10", []any{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+			"SELECT * FROM users WHERE a = 2 AND b = 1 AND c = 10"},
+		{new(SQLServerDialect), "SELECT TOP (@p2) * FROM users WHERE name = @p1", []any{"Ann", 5},
+			"SELECT TOP (5) * FROM users WHERE name = 'Ann'"},
+		{new(OracleDialect), "SELECT * FROM users WHERE name = :1", []any{"Ann"},
+			"SELECT * FROM users WHERE name = 'Ann'"},
+		{new(SQLiteDialect), "SELECT '?', \"a?\" FROM users WHERE name = ?", []any{"Ann"},
+			"SELECT '?', \"a?\" FROM users WHERE name = 'Ann'"},
+	}
+
+	for _, testCase := range testCases {
+		sql, err := interpolate(testCase.dialect, testCase.sql, testCase.args)
+		if err != nil || sql != testCase.expected {
+			t.Fatalf(`Query %s != %s (%v)`, sql, testCase.expected, err)
+		}
+	}
+
+	if _, err := interpolate(new(PostgreSQLDialect), "SELECT $2", []any{1}); err == nil {
+		t.Fatalf("Expected an error for a missing argument")
+	}
+}
+
+// TestBuilderInterpolate
+func TestBuilderInterpolate(t *testing.T) {
+	sql, err := QueryInstance().
+		SetDialect(new(MySQLDialect)).
+		Select("id").
+		From("users").
+		Where("name", Eq, `O'Brien\`).
+		Where("hire_date", Between, ValueBetween{Low: "1999-01-01", High: "2000-12-31"}).
+		Limit(10, 0).
+		Interpolate()
+
+	expected := `SELECT id FROM users WHERE name = 'O''Brien\\' AND hire_date BETWEEN '1999-01-01' AND '2000-12-31' LIMIT 10 OFFSET 0`
+	if err != nil || sql != expected {
+		t.Fatalf(`Query %s != %s (%v)`, sql, expected, err)
+	}
+
+	sql, err = InsertInstance().SetDialect(new(PostgreSQLDialect)).Insert("users", "name", "avatar").Row("O'Brien", []byte{1}).Interpolate()
+	if err != nil || sql != `INSERT INTO users (name, avatar) VALUES ('O''Brien', '\x01'::bytea)` {
+		t.Fatalf("Unexpected query %s (%v)", sql, err)
+	}
+
+	sql, err = UpdateInstance().SetDialect(new(SQLServerDialect)).Update("users").Set("active", true).Where("id", Eq, 1).Interpolate()
+	if err != nil || sql != "UPDATE users SET active = 1 WHERE id = 1" {
+		t.Fatalf("Unexpected query %s (%v)", sql, err)
+	}
+
+	sql, err = DeleteInstance().SetDialect(new(OracleDialect)).Delete("users").Where("name", Eq, "Ann").Interpolate()
+	if err != nil || sql != "DELETE FROM users WHERE name = 'Ann'" {
+		t.Fatalf("Unexpected query %s (%v)", sql, err)
+	}
+
+	sql, err = WhereInstance().SetDialect(new(SQLiteDialect)).Where("name", Eq, "Ann").Interpolate()
+	if err != nil || sql != "WHERE name = 'Ann'" {
+		t.Fatalf("Unexpected query %s (%v)", sql, err)
+	}
+
+	_, err = DeleteInstance().SetDialect(new(MySQLDialect)).Delete("users").Returning("id").Interpolate()
+	if !errors.Is(err, ErrNotSupported) {
+		t.Fatalf("Expected ErrNotSupported, got %v", err)
+	}
+}
+
+// TestStringEscaping
+func TestStringEscaping(t *testing.T) {
+	originalDialect := defaultDialect
+	defer func() {
+		defaultDialect = originalDialect
+	}()
+
+	SetDialect(new(PostgreSQLDialect))
+
+	testCases := map[string]interface{ String() string }{
+		"name = 'O''Brien'":           &Condition{Field: "name", Opt: Eq, Value: "O'Brien"},
+		"name IN ('O''Brien', 'Ann')": &Condition{Field: "name", Opt: In, Value: []string{"O'Brien", "Ann"}},
+		"'a''b' AND 'c'":              ValueBetween{Low: "a'b", High: "c"},
+		"('O''Brien', NULL, 3)":       &InsertRow{Values: []any{"O'Brien", nil, 3}},
+		"name = 'it''s'":              &UpdateItem{Field: "name", Value: "it's"},
+	}
+
+	for expected, clause := range testCases {
+		if clause.String() != expected {
+			t.Fatalf(`Query %s != %s`, clause.String(), expected)
+		}
+	}
+}
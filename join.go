@@ -13,17 +13,30 @@ const (
 	RightJoin
 	FullOuterJoin
 	CrossJoin
+	ArrayJoin     // ARRAY JOIN (ClickHouse), unfolds an array column into rows
+	LeftArrayJoin // LEFT ARRAY JOIN (ClickHouse), keeps the rows with an empty array
 )
 
 // JoinItem represents a single join entry in a SQL statement.
 // Fields:
 //   - Join: The type of join (e.g., InnerJoin, LeftJoin).
-//   - Table: The table name to join.
+//   - Table: The table to join, a string, an Ident, an Expr such as a raw fragment, or a nested query,
+//     a *QueryBuilder or a *CompoundBuilder, whose alias is set with AS.
 //   - Condition: The ON clause condition for the join.
+//   - On: The conditions of the ON clause, joined with AND or with OR as in a WHERE clause, instead of Condition.
+//   - Using: The columns of a USING clause, instead of an ON clause.
+//   - Natural: Whether the join is a NATURAL join, without ON or USING clause.
+//   - Lateral: Whether the joined query may refer to the columns of the preceding tables (LATERAL).
+//     Without condition, the ON clause is "ON true", or the join is a CROSS APPLY or OUTER APPLY on SQL Server
+//     and Oracle.
 type JoinItem struct {
 	Join      JoinType
-	Table     string
+	Table     any
 	Condition Condition
+	On        []Condition
+	Using     []string
+	Natural   bool
+	Lateral   bool
 }
 
 // opt returns the SQL join type as a string based on the JoinType.
@@ -45,11 +58,123 @@ func (j *JoinItem) opt() string {
 		sign = "FULL OUTER JOIN"
 	case CrossJoin:
 		sign = "CROSS JOIN"
+	case ArrayJoin:
+		sign = "ARRAY JOIN"
+	case LeftArrayJoin:
+		sign = "LEFT ARRAY JOIN"
 	}
 
 	return sign
 }
 
+// isArrayJoin reports whether the join is a ClickHouse ARRAY JOIN.
+func (j *JoinItem) isArrayJoin() bool {
+	return j.Join == ArrayJoin || j.Join == LeftArrayJoin
+}
+
+// hasCondition reports whether the join is followed by an ON clause.
+func (j *JoinItem) hasCondition() bool {
+	return j.Join != CrossJoin && !j.isArrayJoin()
+}
+
+// hasOn reports whether the join has ON conditions.
+func (j *JoinItem) hasOn() bool {
+	return len(j.On) > 0 || j.Condition.Field != nil || len(j.Condition.Group) > 0
+}
+
+// isApply reports whether the join is a LATERAL join written as CROSS APPLY or OUTER APPLY on SQL Server and Oracle.
+func (j *JoinItem) isApply(d Dialect) bool {
+	return j.Lateral && !j.hasOn() && (isDialect(d, SQLServer) || isDialect(d, Oracle)) &&
+		(j.Join == InnerJoin || j.Join == CrossJoin || j.Join == LeftJoin)
+}
+
+// build generates the join for the dialect d, in the order of its parameters.
+//
+// Parameters:
+//   - d: The dialect of the builder, the default dialect when nil.
+//   - args []any: A slice of arguments.
+//   - bind: Whether the values are bound as parameters, or inlined as literals.
+//
+// Returns:
+//   - string: E.g. "LEFT JOIN LATERAL (SELECT ...) AS o ON true".
+//   - []any: The updated slice of arguments.
+//   - error: The error of a nested query or expression, when bound.
+func (j *JoinItem) build(d Dialect, args []any, bind bool) (string, []any, error) {
+	var table string
+	var err error
+	if bind {
+		table, args, err = tableSqlArgs(d, j.Table, args)
+		if err != nil {
+			return "", args, err
+		}
+	} else {
+		table = tableSql(d, j.Table)
+	}
+
+	// CROSS APPLY / OUTER APPLY (SELECT ...) AS o
+	if j.isApply(d) {
+		if j.Join == LeftJoin {
+			return "OUTER APPLY " + table, args, nil
+		}
+
+		return "CROSS APPLY " + table, args, nil
+	}
+
+	var sb strings.Builder
+
+	if j.Natural {
+		sb.WriteString("NATURAL ")
+	}
+
+	sb.WriteString(j.opt() + " ")
+
+	if j.Lateral {
+		sb.WriteString("LATERAL ")
+	}
+
+	sb.WriteString(table)
+
+	switch {
+	case !j.hasCondition() || j.Natural:
+		// For CROSS JOIN, ARRAY JOIN and NATURAL JOIN, omit the ON clause
+	case len(j.Using) > 0:
+		sb.WriteString(" USING (" + strings.Join(j.Using, ", ") + ")")
+	case len(j.On) > 0:
+		var items []string
+		for _, condition := range j.On {
+			var sql string
+			if bind {
+				sql, args, err = condition.renderArgs(d, args)
+				if err != nil {
+					return "", args, err
+				}
+			} else {
+				sql = condition.render(d)
+			}
+
+			items = append(items, sql)
+		}
+
+		sb.WriteString(" ON " + joinConditions(j.On, items))
+	case j.Lateral && !j.hasOn():
+		sb.WriteString(" ON true")
+	default:
+		var sql string
+		if bind {
+			sql, args, err = j.Condition.renderArgs(d, args)
+			if err != nil {
+				return "", args, err
+			}
+		} else {
+			sql = j.Condition.render(d)
+		}
+
+		sb.WriteString(" ON " + sql)
+	}
+
+	return sb.String(), args, nil
+}
+
 // Join represents a collection of join statements used in a SQL query.
 // Fields:
 //   - Items: A slice of JoinItem representing all join statements.
@@ -65,24 +190,77 @@ func (j *Join) Append(item JoinItem) {
 	j.Items = append(j.Items, item)
 }
 
+// validate checks the join types against the dialect.
+//
+// Parameters:
+//   - d: The dialect of the builder, the default dialect when nil.
+//   - settings: The settings of the query, which select the ClickHouse join algorithm.
+//
+// Returns:
+//   - error: ErrNotSupported if ARRAY JOIN is used outside ClickHouse, if RIGHT / FULL JOIN is used with
+//     the ClickHouse direct join algorithm, the one of the Dictionary, EmbeddedRocksDB and Join table engines,
+//     if LATERAL is used with SQLite or ClickHouse, or as anything but a CROSS APPLY or OUTER APPLY with SQL Server,
+//     or if NATURAL or USING is used with SQL Server, or NATURAL with ClickHouse. NATURAL and USING are rejected
+//     on every dialect with a CROSS JOIN or an ARRAY JOIN, and a NATURAL join with ON conditions or USING columns.
+func (j *Join) validate(d Dialect, settings *Settings) error {
+	for _, item := range j.Items {
+		if (item.Natural || len(item.Using) > 0) && !item.hasCondition() {
+			return fmt.Errorf("%w: %s NATURAL / USING %s", ErrNotSupported, dialectOr(d).Name(), item.opt())
+		}
+
+		if item.Natural && (item.hasOn() || len(item.Using) > 0) {
+			return fmt.Errorf("%w: %s NATURAL join with ON / USING", ErrNotSupported, dialectOr(d).Name())
+		}
+
+		if item.isArrayJoin() && !isDialect(d, ClickHouse) {
+			return fmt.Errorf("%w: %s %s", ErrNotSupported, dialectOr(d).Name(), item.opt())
+		}
+
+		if item.Lateral && (isDialect(d, SQLite) || isDialect(d, ClickHouse) || (isDialect(d, SQLServer) && !item.isApply(d))) {
+			return fmt.Errorf("%w: %s %s LATERAL", ErrNotSupported, dialectOr(d).Name(), item.opt())
+		}
+
+		if (item.Natural || len(item.Using) > 0) && isDialect(d, SQLServer) {
+			return fmt.Errorf("%w: %s NATURAL / USING join", ErrNotSupported, SQLServer)
+		}
+
+		if item.Natural && isDialect(d, ClickHouse) {
+			return fmt.Errorf("%w: %s NATURAL JOIN", ErrNotSupported, ClickHouse)
+		}
+
+		if (item.Join == RightJoin || item.Join == FullOuterJoin) && isDialect(d, ClickHouse) &&
+			settings.get("join_algorithm") == "direct" {
+			return fmt.Errorf("%w: %s %s with the direct join algorithm", ErrNotSupported, ClickHouse, item.opt())
+		}
+	}
+
+	return nil
+}
+
 // String converts the Join object into a SQL-compatible join string.
 //
 // Returns:
 //   - string: A SQL string representing the join clauses.
 //     Returns an empty string if there are no join items.
 func (j *Join) String() string {
+	return j.render(nil)
+}
+
+// render generates the SQL of String with the dialect d, the default dialect when nil.
+func (j *Join) render(d Dialect) string {
 	if len(j.Items) == 0 {
 		return ""
 	}
 
 	var joinItems []string
 	for _, item := range j.Items {
-		joinStr := fmt.Sprintf("%s %s ON %s", item.opt(), item.Table, item.Condition.String())
-
-		if item.Join == CrossJoin {
-			joinStr = fmt.Sprintf("%s %s", item.opt(), item.Table)
+		// ARRAY JOIN is omitted outside ClickHouse, validate reports it instead
+		if item.isArrayJoin() && !isDialect(d, ClickHouse) {
+			continue
 		}
 
+		joinStr, _, _ := item.build(d, nil, false)
+
 		joinItems = append(joinItems, joinStr)
 	}
 
@@ -0,0 +1,208 @@
+package fluentsql
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// TestJoinSubquery
+func TestJoinSubquery(t *testing.T) {
+	totals := QueryInstance().
+		Select("user_id", Alias(Sum("amount"), "total")).
+		From("orders").
+		Where("status", Eq, "paid").
+		GroupBy("user_id").
+		AS("t")
+
+	sql, args, err := QueryInstance().
+		SetDialect(new(PostgreSQLDialect)).
+		Select("u.id", "t.total").
+		From("users u").
+		JoinOn(InnerJoin, totals, func(where WhereBuilder) *WhereBuilder {
+			return where.
+				Where("t.user_id", Eq, ValueField("u.id")).
+				Where("t.total", Greater, 100).
+				WhereOr("u.vip", Eq, true)
+		}).
+		Where("u.active", Eq, true).
+		Sql()
+
+	expected := "SELECT u.id, t.total FROM users u " +
+		"INNER JOIN (SELECT user_id, SUM(amount) AS total FROM orders WHERE status = $1 GROUP BY user_id) AS t " +
+		"ON t.user_id = u.id AND t.total > $2 OR u.vip = $3 WHERE u.active = $4"
+	if err != nil || sql != expected {
+		t.Fatalf(`Query %s != %s (%v)`, sql, expected, err)
+	}
+
+	if fmt.Sprint(args) != "[paid 100 true true]" {
+		t.Fatalf("Unexpected arguments %v", args)
+	}
+
+	// Oracle writes no AS before the alias of a subquery
+	sql, _, _ = QueryInstance().
+		SetDialect(new(OracleDialect)).
+		From("users u").
+		Join(LeftJoin, QueryInstance().Select("user_id").From("admins").AS("a"), Condition{Field: "a.user_id", Opt: Eq, Value: ValueField("u.id")}).
+		Sql()
+
+	expected = "SELECT * FROM users u LEFT JOIN (SELECT user_id FROM admins) a ON a.user_id = u.id"
+	if sql != expected {
+		t.Fatalf(`Query %s != %s`, sql, expected)
+	}
+}
+
+// TestJoinUsing
+func TestJoinUsing(t *testing.T) {
+	testCases := []struct {
+		query    *QueryBuilder
+		expected string
+	}{
+		{
+			QueryInstance().SetDialect(new(PostgreSQLDialect)).From("orders").JoinUsing(InnerJoin, "customers", "customer_id"),
+			"SELECT * FROM orders INNER JOIN customers USING (customer_id)",
+		},
+		{
+			QueryInstance().SetDialect(new(MySQLDialect)).From("orders").JoinUsing(LeftJoin, Ident("items"), "order_id", "tenant_id"),
+			"SELECT * FROM orders LEFT JOIN `items` USING (order_id, tenant_id)",
+		},
+		{
+			QueryInstance().SetDialect(new(SQLiteDialect)).From("orders").NaturalJoin(InnerJoin, "customers"),
+			"SELECT * FROM orders NATURAL INNER JOIN customers",
+		},
+		{
+			QueryInstance().SetDialect(new(OracleDialect)).From("orders").NaturalJoin(LeftJoin, "customers"),
+			"SELECT * FROM orders NATURAL LEFT JOIN customers",
+		},
+	}
+
+	for _, testCase := range testCases {
+		sql, _, err := testCase.query.Sql()
+		if err != nil || sql != testCase.expected {
+			t.Fatalf(`Query %s != %s (%v)`, sql, testCase.expected, err)
+		}
+	}
+}
+
+// TestJoinLateral
+func TestJoinLateral(t *testing.T) {
+	latest := func() *QueryBuilder {
+		return QueryInstance().
+			Select("total").
+			From("orders").
+			Where("user_id", Eq, ValueField("u.id")).
+			Where("status", Eq, "paid").
+			OrderBy("created_at", Desc).
+			Limit(1, 0).
+			AS("o")
+	}
+
+	testCases := []struct {
+		dialect  Dialect
+		join     JoinType
+		on       FnWhereBuilder
+		expected string
+		args     string
+	}{
+		{
+			new(PostgreSQLDialect), LeftJoin, nil,
+			"SELECT u.id, o.total FROM users u LEFT JOIN LATERAL (SELECT total FROM orders WHERE user_id = u.id " +
+				"AND status = $1 ORDER BY created_at DESC LIMIT $2 OFFSET $3) AS o ON true WHERE u.active = $4",
+			"[paid 1 0 true]",
+		},
+		{
+			new(MySQLDialect), InnerJoin, func(where WhereBuilder) *WhereBuilder { return where.Where("o.total", Greater, 10) },
+			"SELECT u.id, o.total FROM users u INNER JOIN LATERAL (SELECT total FROM orders WHERE user_id = u.id " +
+				"AND status = ? ORDER BY created_at DESC LIMIT ? OFFSET ?) AS o ON o.total > ? WHERE u.active = ?",
+			"[paid 1 0 10 true]",
+		},
+		{
+			new(PostgreSQLDialect), CrossJoin, nil,
+			"SELECT u.id, o.total FROM users u CROSS JOIN LATERAL (SELECT total FROM orders WHERE user_id = u.id " +
+				"AND status = $1 ORDER BY created_at DESC LIMIT $2 OFFSET $3) AS o WHERE u.active = $4",
+			"[paid 1 0 true]",
+		},
+		{
+			new(SQLServerDialect), LeftJoin, nil,
+			"SELECT u.id, o.total FROM users u OUTER APPLY (SELECT TOP (@p2) total FROM orders WHERE user_id = u.id " +
+				"AND status = @p1 ORDER BY created_at DESC) AS o WHERE u.active = @p3",
+			"[paid 1 true]",
+		},
+		{
+			new(OracleDialect), InnerJoin, nil,
+			"SELECT u.id, o.total FROM users u CROSS APPLY (SELECT total FROM orders WHERE user_id = u.id " +
+				"AND status = :1 ORDER BY created_at DESC FETCH FIRST :2 ROWS ONLY) o WHERE u.active = :3",
+			"[paid 1 true]",
+		},
+	}
+
+	for _, testCase := range testCases {
+		sql, args, err := QueryInstance().
+			SetDialect(testCase.dialect).
+			Select("u.id", "o.total").
+			From("users u").
+			JoinLateral(testCase.join, latest(), testCase.on).
+			Where("u.active", Eq, true).
+			Sql()
+
+		if err != nil || sql != testCase.expected {
+			t.Fatalf(`Query %s != %s (%v)`, sql, testCase.expected, err)
+		}
+
+		if fmt.Sprint(args) != testCase.args {
+			t.Fatalf("Arguments %v != %s", args, testCase.args)
+		}
+	}
+}
+
+// TestJoinNotSupported
+func TestJoinNotSupported(t *testing.T) {
+	on := func(where WhereBuilder) *WhereBuilder { return where.Where("o.total", Greater, 10) }
+
+	testCases := []struct {
+		dialect Dialect
+		query   *QueryBuilder
+	}{
+		{new(SQLiteDialect), QueryInstance().From("users u").JoinLateral(LeftJoin, QueryInstance().From("orders").AS("o"), nil)},
+		{new(ClickHouseDialect), QueryInstance().From("users u").JoinLateral(InnerJoin, QueryInstance().From("orders").AS("o"), nil)},
+		{new(SQLServerDialect), QueryInstance().From("users u").JoinLateral(InnerJoin, QueryInstance().From("orders").AS("o"), on)},
+		{new(SQLServerDialect), QueryInstance().From("orders").JoinUsing(InnerJoin, "customers", "customer_id")},
+		{new(SQLServerDialect), QueryInstance().From("orders").NaturalJoin(InnerJoin, "customers")},
+		{new(ClickHouseDialect), QueryInstance().From("orders").NaturalJoin(InnerJoin, "customers")},
+		{new(PostgreSQLDialect), QueryInstance().From("orders").NaturalJoin(CrossJoin, "customers")},
+		{new(MySQLDialect), QueryInstance().From("orders").JoinUsing(CrossJoin, "customers", "customer_id")},
+		{new(ClickHouseDialect), QueryInstance().From("orders").JoinUsing(ArrayJoin, "tags", "tag")},
+	}
+
+	for _, testCase := range testCases {
+		if _, _, err := testCase.query.SetDialect(testCase.dialect).Sql(); !errors.Is(err, ErrNotSupported) {
+			t.Fatalf("Expected ErrNotSupported for %s, got %v", testCase.dialect.Name(), err)
+		}
+	}
+
+	// A NATURAL join has no ON or USING clause
+	join := Join{Items: []JoinItem{{Join: InnerJoin, Table: "customers", Natural: true, Using: []string{"customer_id"}}}}
+	if err := join.validate(new(PostgreSQLDialect), nil); !errors.Is(err, ErrNotSupported) {
+		t.Fatalf("Expected ErrNotSupported, got %v", err)
+	}
+
+	_, _, err := QueryInstance().
+		SetDialect(Strict(new(PostgreSQLDialect))).
+		From("orders").
+		JoinUsing(InnerJoin, "customers", "customer_id) --").
+		Sql()
+	if !errors.Is(err, ErrUnsafeIdent) {
+		t.Fatalf("Expected ErrUnsafeIdent, got %v", err)
+	}
+
+	_, _, err = QueryInstance().
+		SetDialect(Strict(new(PostgreSQLDialect))).
+		From("orders o").
+		JoinOn(InnerJoin, QueryInstance().From("items; --").AS("i"), func(where WhereBuilder) *WhereBuilder {
+			return where.Where("i.order_id", Eq, ValueField("o.id"))
+		}).
+		Sql()
+	if !errors.Is(err, ErrUnsafeIdent) {
+		t.Fatalf("Expected ErrUnsafeIdent, got %v", err)
+	}
+}
@@ -1,11 +1,37 @@
 package fluentsql
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 // Limit clause
 type Limit struct {
-	Limit  int // Limit specifies the maximum number of rows to return.
-	Offset int // Offset specifies the starting point for rows to return.
+	Limit       int   // Limit specifies the maximum number of rows to return.
+	Offset      int   // Offset specifies the starting point for rows to return.
+	LimitParam  Param // LimitParam binds the limit to a named parameter instead of Limit.
+	OffsetParam Param // OffsetParam binds the offset to a named parameter instead of Offset.
+}
+
+// isSet reports whether the limit or the offset is set.
+func (l *Limit) isSet() bool {
+	return l.Limit > 0 || l.Offset > 0 || l.LimitParam != "" || l.OffsetParam != ""
+}
+
+// values returns the limit and offset passed to Dialect.Limit, or their named parameters when set.
+// Negative values are not set, as zero.
+func (l *Limit) values() (any, any) {
+	var limit, offset any = max(l.Limit, 0), max(l.Offset, 0)
+
+	if l.LimitParam != "" {
+		limit = l.LimitParam
+	}
+
+	if l.OffsetParam != "" {
+		offset = l.OffsetParam
+	}
+
+	return limit, offset
 }
 
 // String generates the SQL LIMIT and OFFSET clause string.
@@ -14,12 +40,94 @@ type Limit struct {
 // Returns:
 // - string: The SQL LIMIT and OFFSET clause string.
 func (l *Limit) String() string {
-	// Check if Limit or Offset is greater than zero.
-	if l.Limit > 0 || l.Offset > 0 {
-		// Return formatted LIMIT and OFFSET clause.
-		return fmt.Sprintf("LIMIT %d OFFSET %d", l.Limit, l.Offset)
+	return l.render(nil)
+}
+
+// render generates the SQL of String with the dialect d, the default dialect when nil.
+func (l *Limit) render(d Dialect) string {
+	// Check if Limit or Offset is set.
+	if l.isSet() {
+		limit, offset := l.values()
+
+		// Return the paging clause of the dialect with inlined values.
+		return dialectOr(d).Limit(limit, offset, func(value any) string {
+			return fmt.Sprintf("%v", value)
+		})
 	}
 
 	// Return an empty string if no limit or offset is set.
 	return ""
 }
+
+// top generates the row limit placed after the statement keyword, e.g. "TOP (10)" for SQL Server.
+// A statement uses it instead of the LIMIT clause when no offset is set and the dialect has a TOP clause.
+//
+// Parameters:
+//   - d: The dialect of the builder, the default dialect when nil.
+//
+// Returns:
+//   - string: The TOP clause, or an empty string when the LIMIT clause is used.
+func (l *Limit) top(d Dialect) string {
+	limit, offset := l.values()
+	if limit == 0 || offset != 0 {
+		return ""
+	}
+
+	return dialectOr(d).Top(fmt.Sprintf("%v", limit))
+}
+
+// topArgs generates the TOP clause of top and appends the limit value to the arguments slice.
+//
+// Parameters:
+//   - d: The dialect of the builder, the default dialect when nil.
+//   - args []any: The input slice to which the limit value will be appended.
+//
+// Returns:
+//   - string: The TOP clause, or an empty string when the LIMIT clause is used.
+//   - []any: The updated slice of arguments, unchanged when the LIMIT clause is used.
+func (l *Limit) topArgs(d Dialect, args []any) (string, []any) {
+	if l.top(d) == "" {
+		return "", args
+	}
+
+	limit, _ := l.values()
+	args = append(args, limit)
+
+	return dialectOr(d).Top(p(d, args)), args
+}
+
+// withTop places the TOP clause after the keyword opening a statement,
+// e.g. "SELECT TOP (10) id FROM users".
+//
+// Parameters:
+//   - sql: The statement clause starting with keyword.
+//   - keyword: The keyword after which the TOP clause is placed.
+//   - top: The TOP clause, an empty string leaves sql unchanged.
+//
+// Returns:
+//   - string: The statement clause including the TOP clause.
+func withTop(sql, keyword, top string) string {
+	if top == "" {
+		return sql
+	}
+
+	return keyword + " " + top + strings.TrimPrefix(sql, keyword)
+}
+
+// pagingOrderBy returns the ORDER BY clause of a paged statement. SQL Server only accepts
+// OFFSET ... FETCH after an ORDER BY clause, so an arbitrary order is used when none is set.
+//
+// Parameters:
+//   - d: The dialect of the builder, the default dialect when nil.
+//   - orderBy: The ORDER BY clause of the statement, empty when none is set.
+//   - paged: Whether the statement ends with an OFFSET ... FETCH clause.
+//
+// Returns:
+//   - string: The ORDER BY clause of the statement.
+func pagingOrderBy(d Dialect, orderBy string, paged bool) string {
+	if orderBy == "" && paged && isDialect(d, SQLServer) {
+		return "ORDER BY (SELECT NULL)"
+	}
+
+	return orderBy
+}
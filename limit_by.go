@@ -0,0 +1,69 @@
+package fluentsql
+
+import (
+	"fmt"
+	"strings"
+)
+
+// LimitBy clause represents the ClickHouse LIMIT BY clause, which keeps the first rows of each group
+// of rows sharing the same values of the expressions.
+//
+// Syntax:
+//
+//	LIMIT n [OFFSET m] BY expression [, expression] ...
+type LimitBy struct {
+	Limit  int      // Limit specifies the maximum number of rows of each group.
+	Offset int      // Offset specifies the number of rows of each group to skip.
+	By     []string // By holds the expressions defining the groups.
+}
+
+// limitBy generates the LIMIT BY clause for the dialect from the rendered limit and offset.
+//
+// Parameters:
+//   - d: The dialect of the builder, the default dialect when nil.
+//   - limit: The rendered limit.
+//   - offset: The rendered offset, an empty string when no offset is set.
+//
+// Returns:
+//   - string: The LIMIT BY clause.
+//   - error: ErrNotSupported when the dialect is not ClickHouse.
+func (l *LimitBy) limitBy(d Dialect, limit, offset string) (string, error) {
+	if !isDialect(d, ClickHouse) {
+		return "", fmt.Errorf("%w: %s LIMIT BY", ErrNotSupported, dialectOr(d).Name())
+	}
+
+	parts := []string{"LIMIT " + limit}
+
+	if offset != "" {
+		parts = append(parts, "OFFSET "+offset)
+	}
+
+	parts = append(parts, "BY "+strings.Join(l.By, ", "))
+
+	return strings.Join(parts, " "), nil
+}
+
+// String generates the LIMIT BY clause for the current dialect.
+// The clause is omitted when the dialect is not ClickHouse; use StringArgs to get an error instead.
+//
+// Returns:
+//   - string: E.g. "LIMIT 2 BY user_id". Returns an empty string if no limit or expression is set.
+func (l *LimitBy) String() string {
+	return l.render(nil)
+}
+
+// render generates the SQL of String with the dialect d, the default dialect when nil.
+func (l *LimitBy) render(d Dialect) string {
+	if l.Limit <= 0 || len(l.By) == 0 {
+		return ""
+	}
+
+	var offset string
+	if l.Offset > 0 {
+		offset = fmt.Sprintf("%d", l.Offset)
+	}
+
+	sql, _ := l.limitBy(d, fmt.Sprintf("%d", l.Limit), offset)
+
+	return sql
+}
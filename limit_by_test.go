@@ -0,0 +1,46 @@
+package fluentsql
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestLimitBy
+func TestLimitBy(t *testing.T) {
+	originalDialect := defaultDialect
+	defer func() {
+		defaultDialect = originalDialect
+	}()
+
+	SetDialect(new(ClickHouseDialect))
+
+	testCases := map[string]LimitBy{
+		"":                                     {},
+		"LIMIT 2 BY user_id":                   {Limit: 2, By: []string{"user_id"}},
+		"LIMIT 2 OFFSET 1 BY user_id, country": {Limit: 2, Offset: 1, By: []string{"user_id", "country"}},
+	}
+
+	for expected, limitBy := range testCases {
+		if limitBy.String() != expected {
+			t.Fatalf(`Query %s != %s`, limitBy.String(), expected)
+		}
+	}
+
+	limitBy := LimitBy{Limit: 2, Offset: 1, By: []string{"user_id"}}
+
+	sql, args, err := limitBy.StringArgs(nil)
+	if err != nil || sql != "LIMIT ? OFFSET ? BY user_id" || len(args) != 2 {
+		t.Fatalf("Query %s != LIMIT ? OFFSET ? BY user_id (%v, %v)", sql, args, err)
+	}
+
+	// LIMIT BY is a ClickHouse clause
+	SetDialect(new(PostgreSQLDialect))
+
+	if limitBy.String() != "" {
+		t.Fatalf("Expected empty LIMIT BY clause, got %s", limitBy.String())
+	}
+
+	if _, _, err := limitBy.StringArgs(nil); !errors.Is(err, ErrNotSupported) {
+		t.Fatalf("Expected ErrNotSupported, got %v", err)
+	}
+}
@@ -29,3 +29,44 @@ func TestFetch(t *testing.T) {
 		t.Fatalf(`Query %s != %s`, limitTest.String(), expected)
 	}
 }
+
+// TestLimitDialect
+func TestLimitDialect(t *testing.T) {
+	originalDialect := defaultDialect
+	defer func() {
+		defaultDialect = originalDialect
+	}()
+
+	limitTest := Limit{Limit: 10, Offset: 20}
+
+	SetDialect(new(MySQLDialect))
+
+	if sql, args := limitTest.StringArgs(nil); sql != "LIMIT ? OFFSET ?" || len(args) != 2 {
+		t.Fatalf("Query %s != LIMIT ? OFFSET ? (%v)", sql, args)
+	}
+
+	// SQL Server pages with OFFSET ... FETCH, or TOP without an offset
+	SetDialect(new(SQLServerDialect))
+
+	expected := "OFFSET @p1 ROWS FETCH NEXT @p2 ROWS ONLY"
+	if sql, args := limitTest.StringArgs(nil); sql != expected || len(args) != 2 {
+		t.Fatalf("Query %s != %s (%v)", sql, expected, args)
+	}
+
+	if limitTest.top(nil) != "" {
+		t.Fatalf("Unexpected TOP clause %s", limitTest.top(nil))
+	}
+
+	limitTest.Offset = 0
+
+	if top, args := limitTest.topArgs(nil, []any{1}); top != "TOP (@p2)" || len(args) != 2 {
+		t.Fatalf("Query %s != TOP (@p2) (%v)", top, args)
+	}
+	// An offset without limit has no FETCH clause
+	limitTest = Limit{Offset: 20}
+
+	expected = "OFFSET @p1 ROWS"
+	if sql, args := limitTest.StringArgs(nil); sql != expected || len(args) != 1 {
+		t.Fatalf("Query %s != %s (%v)", sql, expected, args)
+	}
+}
@@ -0,0 +1,146 @@
+package fluentsql
+
+import (
+	"fmt"
+	"strings"
+)
+
+// LockStrength represents the strength of a row-level lock.
+type LockStrength int
+
+const (
+	LockNone           LockStrength = iota // No locking clause
+	LockForUpdate                          // FOR UPDATE
+	LockForNoKeyUpdate                     // FOR NO KEY UPDATE (PostgreSQL)
+	LockForShare                           // FOR SHARE
+	LockForKeyShare                        // FOR KEY SHARE (PostgreSQL)
+)
+
+// LockWait represents the behaviour when a row is already locked by another transaction.
+type LockWait int
+
+const (
+	LockWaitDefault LockWait = iota // Wait for the lock to be released
+	LockNoWait                      // Report an error instead of waiting (NOWAIT)
+	LockSkipLocked                  // Skip the locked rows (SKIP LOCKED)
+)
+
+// Lock clause represents the row-locking clause of a SELECT statement.
+//
+// Syntax:
+//
+//	FOR {UPDATE | NO KEY UPDATE | SHARE | KEY SHARE}
+//	    [OF tbl_name [, tbl_name] ...]
+//	    [NOWAIT | SKIP LOCKED]
+//	| LOCK IN SHARE MODE
+type Lock struct {
+	Strength LockStrength // Strength specifies the lock strength. LockNone disables the clause.
+	Of       []string     // Of restricts the lock to the listed tables.
+	Wait     LockWait     // Wait specifies the NOWAIT / SKIP LOCKED option.
+}
+
+// strength returns the SQL keywords of the lock strength.
+//
+// Returns:
+//   - string: "FOR UPDATE", "FOR NO KEY UPDATE", "FOR SHARE" or "FOR KEY SHARE".
+func (l *Lock) strength() string {
+	var sign string
+
+	switch l.Strength {
+	case LockForUpdate:
+		sign = "FOR UPDATE"
+	case LockForNoKeyUpdate:
+		sign = "FOR NO KEY UPDATE"
+	case LockForShare:
+		sign = "FOR SHARE"
+	case LockForKeyShare:
+		sign = "FOR KEY SHARE"
+	}
+
+	return sign
+}
+
+// wait returns the SQL keywords of the wait option.
+//
+// Returns:
+//   - string: "NOWAIT", "SKIP LOCKED" or an empty string.
+func (l *Lock) wait() string {
+	var sign string
+
+	switch l.Wait {
+	case LockNoWait:
+		sign = "NOWAIT"
+	case LockSkipLocked:
+		sign = "SKIP LOCKED"
+	}
+
+	return sign
+}
+
+// lock generates the locking clause for the dialect.
+//
+// Parameters:
+//   - d: The dialect of the builder, the default dialect when nil.
+//
+// Returns:
+//   - string: The locking clause. Returns an empty string if no lock is set.
+//   - error: ErrNotSupported when the dialect cannot express the lock.
+//
+// Notes:
+//   - SQLite and ClickHouse have no row-level locks.
+//   - Oracle only has FOR UPDATE.
+//   - MySQL has no NO KEY UPDATE / KEY SHARE strength, and a plain shared lock
+//     is rendered as LOCK IN SHARE MODE.
+func (l *Lock) lock(d Dialect) (string, error) {
+	if l.Strength == LockNone {
+		return "", nil
+	}
+
+	// SQL Server locks rows with table hints, e.g. WITH (UPDLOCK), rather than a locking clause.
+	if isDialect(d, SQLite) || isDialect(d, SQLServer) || isDialect(d, ClickHouse) {
+		return "", fmt.Errorf("%w: %s row locking", ErrNotSupported, dialectOr(d).Name())
+	}
+
+	// Oracle only has exclusive row locks.
+	if isDialect(d, Oracle) && l.Strength != LockForUpdate {
+		return "", fmt.Errorf("%w: %s %s", ErrNotSupported, Oracle, l.strength())
+	}
+
+	if isDialect(d, MySQL) {
+		if l.Strength == LockForNoKeyUpdate || l.Strength == LockForKeyShare {
+			return "", fmt.Errorf("%w: %s %s", ErrNotSupported, MySQL, l.strength())
+		}
+
+		if l.Strength == LockForShare && len(l.Of) == 0 && l.Wait == LockWaitDefault {
+			return "LOCK IN SHARE MODE", nil
+		}
+	}
+
+	parts := []string{l.strength()}
+
+	if len(l.Of) > 0 {
+		parts = append(parts, fmt.Sprintf("OF %s", strings.Join(l.Of, ", ")))
+	}
+
+	if wait := l.wait(); wait != "" {
+		parts = append(parts, wait)
+	}
+
+	return strings.Join(parts, " "), nil
+}
+
+// String generates the SQL locking clause for the current dialect.
+// Locks the dialect cannot express are omitted; use StringArgs to get an error instead.
+//
+// Returns:
+//   - string: E.g. "FOR UPDATE OF jobs SKIP LOCKED". Returns an empty string if no lock is set.
+func (l *Lock) String() string {
+	return l.render(nil)
+}
+
+// render generates the SQL of String with the dialect d, the default dialect when nil.
+func (l *Lock) render(d Dialect) string {
+	sql, _ := l.lock(d)
+
+	return sql
+}
@@ -0,0 +1,67 @@
+package fluentsql
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestLock
+func TestLock(t *testing.T) {
+	testCases := map[string]Lock{
+		"":                                  {},
+		"FOR UPDATE":                        {Strength: LockForUpdate},
+		"FOR NO KEY UPDATE NOWAIT":          {Strength: LockForNoKeyUpdate, Wait: LockNoWait},
+		"FOR SHARE OF orders, customers":    {Strength: LockForShare, Of: []string{"orders", "customers"}},
+		"FOR KEY SHARE OF jobs SKIP LOCKED": {Strength: LockForKeyShare, Of: []string{"jobs"}, Wait: LockSkipLocked},
+	}
+
+	for expected, lock := range testCases {
+		if lock.String() != expected {
+			t.Fatalf(`Query %s != %s`, lock.String(), expected)
+		}
+	}
+}
+
+// TestLockDialect
+func TestLockDialect(t *testing.T) {
+	// Save the original dialect to restore it after the test
+	originalDialect := defaultDialect
+	defer func() {
+		defaultDialect = originalDialect
+	}()
+
+	// MySQL renders a plain shared lock as LOCK IN SHARE MODE
+	SetDialect(new(MySQLDialect))
+
+	lock := Lock{Strength: LockForShare}
+	if lock.String() != "LOCK IN SHARE MODE" {
+		t.Fatalf("Expected LOCK IN SHARE MODE, got %s", lock.String())
+	}
+
+	lock = Lock{Strength: LockForShare, Wait: LockNoWait}
+	if lock.String() != "FOR SHARE NOWAIT" {
+		t.Fatalf("Expected FOR SHARE NOWAIT, got %s", lock.String())
+	}
+
+	lock = Lock{Strength: LockForUpdate, Of: []string{"jobs"}, Wait: LockSkipLocked}
+	if lock.String() != "FOR UPDATE OF jobs SKIP LOCKED" {
+		t.Fatalf("Expected FOR UPDATE OF jobs SKIP LOCKED, got %s", lock.String())
+	}
+
+	lock = Lock{Strength: LockForNoKeyUpdate}
+	if _, _, err := lock.StringArgs(nil); !errors.Is(err, ErrNotSupported) {
+		t.Fatalf("Expected ErrNotSupported, got %v", err)
+	}
+
+	// SQLite has no row locks
+	SetDialect(new(SQLiteDialect))
+
+	lock = Lock{Strength: LockForUpdate}
+	if lock.String() != "" {
+		t.Fatalf("Expected empty locking clause, got %s", lock.String())
+	}
+
+	if _, _, err := lock.StringArgs(nil); !errors.Is(err, ErrNotSupported) {
+		t.Fatalf("Expected ErrNotSupported, got %v", err)
+	}
+}
@@ -2,6 +2,7 @@ package fluentsql
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 )
 
@@ -18,14 +19,84 @@ const (
 	Desc                   // Descending order.
 )
 
+// NullsOrder represents the position of the NULL values in a sort.
+//
+// Values:
+// - NullsDefault: The position of the dialect.
+// - NullsFirst: NULL values first (NULLS FIRST).
+// - NullsLast: NULL values last (NULLS LAST).
+type NullsOrder int
+
+// Constants representing the positions of the NULL values.
+const (
+	NullsDefault NullsOrder = iota // The position of the dialect.
+	NullsFirst                     // NULL values first.
+	NullsLast                      // NULL values last.
+)
+
 // SortItem defines a single field and its sorting direction for the ORDER BY clause.
 //
 // Fields:
-// - Field (string): The name of the field to sort by.
+// - Field (any): The field to sort by, a string, an Ident, an expression, or an int for the position of a select column.
 // - Direction (OrderByDir): The direction of sorting (Asc or Desc).
+// - Nulls (NullsOrder): The position of the NULL values, emulated with an IS NULL sort item on MySQL and a CASE
+// sort item on SQL Server, and ignored by these dialects for a position.
+// - Collation (string): The collation of the sort, written as is after the field (COLLATE), and as a literal at the
+// end of the sort item on ClickHouse.
 type SortItem struct {
-	Field     string     // The field to sort by.
+	Field     any        // The field to sort by.
 	Direction OrderByDir // The direction of the sort (Asc or Desc).
+	Nulls     NullsOrder // The position of the NULL values.
+	Collation string     // The collation of the sort.
+}
+
+// Sort creates a sort item, see QueryBuilder.OrderBySort.
+//
+// Example:
+//
+//	Sort("created_at", Desc).NullsLast() // created_at DESC NULLS LAST
+//
+// Parameters:
+// - field any: The field to sort by.
+// - dir OrderByDir: The direction of sorting (Asc or Desc).
+//
+// Returns:
+// - SortItem: The sort item.
+func Sort(field any, dir OrderByDir) SortItem {
+	return SortItem{
+		Field:     field,
+		Direction: dir,
+	}
+}
+
+// NullsFirst sorts the NULL values first.
+//
+// Returns:
+// - SortItem: A copy of the sort item.
+func (o SortItem) NullsFirst() SortItem {
+	o.Nulls = NullsFirst
+
+	return o
+}
+
+// NullsLast sorts the NULL values last.
+//
+// Returns:
+// - SortItem: A copy of the sort item.
+func (o SortItem) NullsLast() SortItem {
+	o.Nulls = NullsLast
+
+	return o
+}
+
+// Collate sets the collation of the sort, e.g. `"C"` on PostgreSQL or "utf8mb4_bin" on MySQL.
+//
+// Returns:
+// - SortItem: A copy of the sort item.
+func (o SortItem) Collate(collation string) SortItem {
+	o.Collation = collation
+
+	return o
 }
 
 // OrderBy represents the ORDER BY clause of a SQL query.
@@ -56,9 +127,9 @@ func (o *SortItem) Dir() string {
 // Append adds a new field and its sorting direction to the ORDER BY clause.
 //
 // Parameters:
-// - field string: The name of the field to add.
+// - field any: The field to add, a string, an Ident, an expression or the position of a select column.
 // - dir OrderByDir: The direction of sorting (Asc or Desc).
-func (o *OrderBy) Append(field string, dir OrderByDir) {
+func (o *OrderBy) Append(field any, dir OrderByDir) {
 	// Add new SortItem to the Items slice.
 	o.Items = append(o.Items, SortItem{
 		Field:     field,
@@ -71,17 +142,126 @@ func (o *OrderBy) Append(field string, dir OrderByDir) {
 // Returns:
 // - string: The constructed ORDER BY clause. Returns an empty string if no fields are specified.
 func (o *OrderBy) String() string {
+	return o.render(nil)
+}
+
+// render generates the SQL of String with the dialect d, the default dialect when nil.
+func (o *OrderBy) render(d Dialect) string {
+	sql, _, _ := o.build(d, nil, false)
+
+	return sql
+}
+
+// build generates the ORDER BY clause for the dialect d, in the order of its parameters.
+//
+// Parameters:
+// - d Dialect: The dialect of the builder, the default dialect when nil.
+// - args []any: A slice of arguments.
+// - bind bool: Whether the values of the expressions are bound as parameters, or inlined as literals.
+//
+// Returns:
+// - string: The ORDER BY clause. Returns an empty string if no fields are specified.
+// - []any: The updated slice of arguments.
+// - error: The error of an expression, when bound.
+func (o *OrderBy) build(d Dialect, args []any, bind bool) (string, []any, error) {
 	// Return empty string if no items are present.
 	if len(o.Items) == 0 {
-		return ""
+		return "", args, nil
 	}
 
 	var orderItems []string // Holds individual order by items in string format.
 	for _, item := range o.Items {
-		// Construct field-direction pair and append to the list.
-		orderItems = append(orderItems, fmt.Sprintf("%s %s", item.Field, item.Dir()))
+		var sql []string
+		var err error
+
+		sql, args, err = item.build(d, args, bind)
+		if err != nil {
+			return "", args, err
+		}
+
+		orderItems = append(orderItems, sql...)
 	}
 
 	// Join all items and prefix with "ORDER BY".
-	return fmt.Sprintf("ORDER BY %s", strings.Join(orderItems, ", "))
+	return fmt.Sprintf("ORDER BY %s", strings.Join(orderItems, ", ")), args, nil
+}
+
+// build generates the sort item for the dialect d, preceded by the sort item emulating its NULLS position.
+//
+// Parameters:
+// - d Dialect: The dialect of the builder, the default dialect when nil.
+// - args []any: A slice of arguments.
+// - bind bool: Whether the values of the expressions are bound as parameters, or inlined as literals.
+//
+// Returns:
+// - []string: The sort items, e.g. "created_at IS NULL ASC" and "created_at DESC" on MySQL.
+// - []any: The updated slice of arguments.
+// - error: The error of an expression, when bound.
+func (o *SortItem) build(d Dialect, args []any, bind bool) ([]string, []any, error) {
+	field := func() (string, error) {
+		if position, ok := o.Field.(int); ok {
+			return strconv.Itoa(position), nil
+		}
+
+		var value any
+		var err error
+		if bind {
+			value, args, err = dialectFieldArgs(d, o.Field, args)
+		} else {
+			value = dialectField(d, o.Field)
+		}
+
+		return fmt.Sprintf("%s", value), err
+	}
+
+	var items []string
+
+	// MySQL and SQL Server emulate NULLS FIRST / LAST by sorting on the nullity of the field first
+	_, position := o.Field.(int)
+	native := !isDialect(d, MySQL) && !isDialect(d, SQLServer)
+
+	if o.Nulls != NullsDefault && !native && !position {
+		// The NULL values sort as 1, after the other values in ascending order
+		dir := "ASC"
+		if o.Nulls == NullsFirst {
+			dir = "DESC"
+		}
+
+		nullity, err := field()
+		if err != nil {
+			return nil, args, err
+		}
+
+		if isDialect(d, SQLServer) {
+			items = append(items, fmt.Sprintf("CASE WHEN %s IS NULL THEN 1 ELSE 0 END %s", nullity, dir))
+		} else {
+			items = append(items, fmt.Sprintf("%s IS NULL %s", nullity, dir))
+		}
+	}
+
+	sql, err := field()
+	if err != nil {
+		return nil, args, err
+	}
+
+	if o.Collation != "" && !isDialect(d, ClickHouse) {
+		sql += " COLLATE " + o.Collation
+	}
+
+	sql += " " + o.Dir()
+
+	if native {
+		switch o.Nulls {
+		case NullsFirst:
+			sql += " NULLS FIRST"
+		case NullsLast:
+			sql += " NULLS LAST"
+		}
+	}
+
+	if o.Collation != "" && isDialect(d, ClickHouse) {
+		sql += " COLLATE " + inline(d, o.Collation)
+	}
+
+	return append(items, sql), args, nil
 }
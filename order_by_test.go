@@ -1,6 +1,7 @@
 package fluentsql
 
 import (
+	"fmt"
 	"testing"
 )
 
@@ -28,3 +29,80 @@ func TestOrderByTestOrderMultiDesc(t *testing.T) {
 		t.Fatalf(`Query %s != %s`, orderByTest.String(), expected)
 	}
 }
+
+// TestOrderBySort
+func TestOrderBySort(t *testing.T) {
+	testCases := []struct {
+		dialect  Dialect
+		items    []SortItem
+		expected string
+	}{
+		{new(PostgreSQLDialect), []SortItem{Sort("created_at", Desc).NullsLast()}, "ORDER BY created_at DESC NULLS LAST"},
+		{new(OracleDialect), []SortItem{Sort("name", Asc).NullsFirst()}, "ORDER BY name ASC NULLS FIRST"},
+		{new(MySQLDialect), []SortItem{Sort("created_at", Desc).NullsLast()}, "ORDER BY created_at IS NULL ASC, created_at DESC"},
+		{new(MySQLDialect), []SortItem{Sort("created_at", Asc).NullsFirst()}, "ORDER BY created_at IS NULL DESC, created_at ASC"},
+		{new(SQLServerDialect), []SortItem{Sort("created_at", Desc).NullsLast()},
+			"ORDER BY CASE WHEN created_at IS NULL THEN 1 ELSE 0 END ASC, created_at DESC"},
+		{new(PostgreSQLDialect), []SortItem{Sort("name", Asc).Collate(`"C"`)}, `ORDER BY name COLLATE "C" ASC`},
+		{new(MySQLDialect), []SortItem{Sort(Ident("name"), Desc).Collate("utf8mb4_bin")}, "ORDER BY `name` COLLATE utf8mb4_bin DESC"},
+		{new(ClickHouseDialect), []SortItem{Sort("name", Asc).NullsLast().Collate("en")}, "ORDER BY name ASC NULLS LAST COLLATE 'en'"},
+		{new(PostgreSQLDialect), []SortItem{Sort(2, Desc), Sort(1, Asc)}, "ORDER BY 2 DESC, 1 ASC"},
+		{new(MySQLDialect), []SortItem{Sort(2, Desc).NullsLast()}, "ORDER BY 2 DESC"},
+		{new(MySQLDialect), []SortItem{Sort(Func("FIELD", "status", Lit("new"), Lit("open")), Asc)},
+			"ORDER BY FIELD(status, 'new', 'open') ASC"},
+	}
+
+	for _, testCase := range testCases {
+		orderBy := OrderBy{Items: testCase.items}
+		if sql := orderBy.render(testCase.dialect); sql != testCase.expected {
+			t.Fatalf(`Query %s != %s`, sql, testCase.expected)
+		}
+	}
+}
+
+// TestOrderBySortStatement
+func TestOrderBySortStatement(t *testing.T) {
+	sql, args, err := QueryInstance().
+		SetDialect(new(MySQLDialect)).
+		Select("id", "status").
+		From("tickets").
+		Where("status", NotEq, "closed").
+		OrderBy(Func("FIELD", "status", Arg("urgent"), Arg("open")), Asc).
+		OrderBySort(Sort(Coalesce("due_at", Arg("2030-01-01")), Asc).NullsLast()).
+		Sql()
+
+	expected := "SELECT id, status FROM tickets WHERE status <> ? ORDER BY FIELD(status, ?, ?) ASC, " +
+		"COALESCE(due_at, ?) IS NULL ASC, COALESCE(due_at, ?) ASC"
+	if err != nil || sql != expected {
+		t.Fatalf(`Query %s != %s (%v)`, sql, expected, err)
+	}
+
+	if fmt.Sprint(args) != "[closed urgent open 2030-01-01 2030-01-01]" {
+		t.Fatalf("Unexpected arguments %v", args)
+	}
+
+	sql, _, _ = UpdateInstance().
+		SetDialect(new(MySQLDialect)).
+		Update("jobs").
+		Set("status", "queued").
+		Where("status", Eq, "new").
+		OrderBySort(Sort("priority", Desc).NullsLast()).
+		OrderBy("id", Asc).
+		Sql()
+
+	expected = "UPDATE jobs SET status = ? WHERE status = ? ORDER BY priority IS NULL ASC, priority DESC, id ASC"
+	if sql != expected {
+		t.Fatalf(`Query %s != %s`, sql, expected)
+	}
+
+	sql, _, _ = DeleteInstance().
+		SetDialect(new(PostgreSQLDialect)).
+		Delete("logs").
+		OrderBySort(Sort("created_at", Asc).NullsFirst()).
+		Sql()
+
+	expected = "DELETE FROM logs ORDER BY created_at ASC NULLS FIRST"
+	if sql != expected {
+		t.Fatalf(`Query %s != %s`, sql, expected)
+	}
+}
@@ -0,0 +1,162 @@
+package fluentsql
+
+import "fmt"
+
+// Param represents a named parameter, bound to a value when the statement is executed rather than when it is built.
+// A Param can be used as a condition value, an INSERT value, a SET value, an operand of an expression, and as the
+// limit, offset or fetch of a query with LimitParam and FetchParam. As the value of an IN or NOT IN condition, the
+// parameter is one array compared with "= ANY" or "<> ALL" on PostgreSQL; Sql returns ErrNotSupported elsewhere.
+//
+// StringArgs writes a placeholder and appends the Param itself to the arguments slice, Compile or SqlParams then
+// replace it with its value. String writes the name of the parameter, e.g. ":email".
+//
+// Example:
+//
+//	compiled, err := QueryInstance().From("users").Where("email", Eq, Param("email")).Compile()
+//	// SELECT * FROM users WHERE email = $1
+//	args, err := compiled.Bind(map[string]any{"email": "ann@example.com"})
+type Param string
+
+// String writes the name of the parameter, e.g. ":email".
+func (param Param) String() string {
+	return param.render(nil)
+}
+
+// render writes the name of the parameter, the dialect is not used.
+func (param Param) render(_ Dialect) string {
+	return ":" + string(param)
+}
+
+// StringArgs writes a placeholder of the default dialect and appends the parameter to the arguments slice.
+func (param Param) StringArgs(args []any) (string, []any) {
+	sql, args, _ := param.renderArgs(nil, args)
+
+	return sql, args
+}
+
+// renderArgs generates the SQL of StringArgs with the dialect d, the default dialect when nil.
+func (param Param) renderArgs(d Dialect, args []any) (string, []any, error) {
+	args = append(args, param)
+
+	return p(d, args), args, nil
+}
+
+// Compiled is a statement generated once, with its named parameters bound on each execution.
+// A Compiled statement is immutable and safe for concurrent use.
+type Compiled struct {
+	sql   string              // sql is the statement with placeholders.
+	args  []any               // args holds the arguments of the statement, Param values are replaced by Bind.
+	names map[string]struct{} // names holds the names of the parameters of the statement.
+}
+
+// compile creates the Compiled statement of the result of a Sql method.
+//
+// Parameters:
+//   - sql: The statement with placeholders.
+//   - args: The arguments of the statement, holding Param values.
+//   - err: The error of the Sql method, returned as is.
+//
+// Returns:
+//   - *Compiled: The compiled statement, nil on error.
+//   - error: The error of the Sql method.
+func compile(sql string, args []any, err error) (*Compiled, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	names := map[string]struct{}{}
+	for _, arg := range args {
+		if param, ok := arg.(Param); ok {
+			names[string(param)] = struct{}{}
+		}
+	}
+
+	return &Compiled{sql: sql, args: args, names: names}, nil
+}
+
+// Sql returns the statement with placeholders.
+func (c *Compiled) Sql() string {
+	return c.sql
+}
+
+// Names returns the names of the parameters of the statement, in no particular order.
+func (c *Compiled) Names() []string {
+	names := make([]string, 0, len(c.names))
+	for name := range c.names {
+		names = append(names, name)
+	}
+
+	return names
+}
+
+// Bind returns the arguments of the statement with the named parameters replaced by their values.
+// Only the arguments slice is allocated when the values are given as a map.
+//
+// Parameters:
+//   - params: The values as a map[string]any, or as a tagged struct (or a pointer to one) whose fields are
+//     matched by column name, see StructOptions. A nil value binds a statement without parameters.
+//
+// Returns:
+//   - []any: The arguments of the statement.
+//   - error: ErrMissingParam when a parameter has no value, ErrUnknownParam when a map key is not
+//     a parameter of the statement, or an error when params is neither a map nor a struct.
+func (c *Compiled) Bind(params any) ([]any, error) {
+	switch values := params.(type) {
+	case map[string]any:
+		for name := range values {
+			if _, ok := c.names[name]; !ok {
+				return nil, fmt.Errorf("%w: %q", ErrUnknownParam, name)
+			}
+		}
+
+		return c.bind(func(name string) (any, bool) {
+			value, ok := values[name]
+
+			return value, ok
+		})
+	case nil:
+		return c.bind(func(string) (any, bool) {
+			return nil, false
+		})
+	}
+
+	v, meta := structOf(params)
+	if meta == nil {
+		return nil, fmt.Errorf("fluentsql: parameters must be a map[string]any or a struct, got %T", params)
+	}
+
+	return c.bind(func(name string) (any, bool) {
+		field, ok := meta.Lookup(name)
+		if !ok || !v.IsValid() {
+			return nil, false
+		}
+
+		return structValue(v, field), true
+	})
+}
+
+// bind copies the arguments of the statement, replacing the named parameters with the values of lookup.
+//
+// Returns:
+//   - []any: The arguments of the statement.
+//   - error: ErrMissingParam for the first parameter without value.
+func (c *Compiled) bind(lookup func(name string) (any, bool)) ([]any, error) {
+	args := make([]any, len(c.args))
+
+	for i, arg := range c.args {
+		param, ok := arg.(Param)
+		if !ok {
+			args[i] = arg
+			continue
+		}
+
+		value, ok := lookup(string(param))
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", ErrMissingParam, string(param))
+		}
+
+		args[i] = value
+	}
+
+	return args, nil
+}
@@ -0,0 +1,215 @@
+package fluentsql
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// TestCompile
+func TestCompile(t *testing.T) {
+	compiled, err := QueryInstance().
+		SetDialect(new(PostgreSQLDialect)).
+		From("users").
+		Where("status", Eq, "active").
+		Where("email", Eq, Param("email")).
+		WhereOr("nickname", Eq, Func("LOWER", Param("email"))).
+		LimitParam("size", "skip").
+		Compile()
+
+	expected := "SELECT * FROM users WHERE status = $1 AND email = $2 OR nickname = LOWER($3) LIMIT $4 OFFSET $5"
+	if err != nil || compiled.Sql() != expected {
+		t.Fatalf(`Query %v != %s (%v)`, compiled, expected, err)
+	}
+
+	args, err := compiled.Bind(map[string]any{"email": "ann@example.com", "size": 10, "skip": 20})
+	if err != nil || fmt.Sprint(args) != "[active ann@example.com ann@example.com 10 20]" {
+		t.Fatalf("Unexpected arguments %v (%v)", args, err)
+	}
+
+	// Each call binds a new slice of arguments
+	args, _ = compiled.Bind(map[string]any{"email": "bob@example.com", "size": 5, "skip": 0})
+	if fmt.Sprint(args) != "[active bob@example.com bob@example.com 5 0]" {
+		t.Fatalf("Unexpected arguments %v", args)
+	}
+
+	if _, err = compiled.Bind(map[string]any{"email": "ann@example.com", "size": 10}); !errors.Is(err, ErrMissingParam) {
+		t.Fatalf("Expected ErrMissingParam, got %v", err)
+	}
+
+	if _, err = compiled.Bind(map[string]any{"email": "a", "size": 1, "skip": 0, "sort": "id"}); !errors.Is(err, ErrUnknownParam) {
+		t.Fatalf("Expected ErrUnknownParam, got %v", err)
+	}
+
+	if _, err = compiled.Bind(nil); !errors.Is(err, ErrMissingParam) {
+		t.Fatalf("Expected ErrMissingParam, got %v", err)
+	}
+
+	if _, err = compiled.Bind(42); err == nil {
+		t.Fatalf("Expected an error for parameters of type int")
+	}
+}
+
+// TestBindStruct
+func TestBindStruct(t *testing.T) {
+	type filter struct {
+		Email string `db:"email"`
+		Size  int    `db:"size"`
+		Notes string `db:"notes"`
+	}
+
+	compiled, _ := QueryInstance().
+		SetDialect(new(MySQLDialect)).
+		From("users").
+		Where("email", Eq, Param("email")).
+		LimitParam("size", "").
+		Compile()
+
+	args, err := compiled.Bind(&filter{Email: "ann@example.com", Size: 10})
+	if err != nil || compiled.Sql() != "SELECT * FROM users WHERE email = ? LIMIT ? OFFSET ?" ||
+		fmt.Sprint(args) != "[ann@example.com 10 0]" {
+		t.Fatalf("Unexpected query %s %v (%v)", compiled.Sql(), args, err)
+	}
+
+	compiled, _ = QueryInstance().From("users").Where("name", Eq, Param("name")).Compile()
+	if _, err = compiled.Bind(filter{}); !errors.Is(err, ErrMissingParam) {
+		t.Fatalf("Expected ErrMissingParam, got %v", err)
+	}
+}
+
+// TestSqlParams
+func TestSqlParams(t *testing.T) {
+	params := map[string]any{"name": "Ann", "role": "admin"}
+
+	sql, args, err := InsertInstance().
+		SetDialect(new(PostgreSQLDialect)).
+		Insert("users", "name", "role", "active").
+		Row(Param("name"), Param("role"), true).
+		SqlParams(params)
+
+	if err != nil || sql != "INSERT INTO users (name, role, active) VALUES ($1, $2, $3)" || fmt.Sprint(args) != "[Ann admin true]" {
+		t.Fatalf("Unexpected query %s %v (%v)", sql, args, err)
+	}
+
+	sql, args, err = UpdateInstance().
+		SetDialect(new(PostgreSQLDialect)).
+		Update("users").
+		Set("role", Param("role")).
+		Where("name", Eq, Param("name")).
+		SqlParams(params)
+
+	if err != nil || sql != "UPDATE users SET role = $1 WHERE name = $2" || fmt.Sprint(args) != "[admin Ann]" {
+		t.Fatalf("Unexpected query %s %v (%v)", sql, args, err)
+	}
+
+	sql, args, err = DeleteInstance().
+		SetDialect(new(PostgreSQLDialect)).
+		Delete("users").
+		Where("name", Eq, Param("name")).
+		SqlParams(map[string]any{"name": "Ann"})
+
+	if err != nil || sql != "DELETE FROM users WHERE name = $1" || fmt.Sprint(args) != "[Ann]" {
+		t.Fatalf("Unexpected query %s %v (%v)", sql, args, err)
+	}
+
+	sql, args, err = CompoundInstance(QueryInstance().Select("id").From("users").Where("name", Eq, Param("name"))).
+		SetDialect(new(PostgreSQLDialect)).
+		Union(QueryInstance().Select("id").From("admins")).
+		LimitParam("size", "").
+		SqlParams(map[string]any{"name": "Ann", "size": 5})
+
+	if err != nil || fmt.Sprint(args) != "[Ann 5 0]" {
+		t.Fatalf("Unexpected query %s %v (%v)", sql, args, err)
+	}
+
+	_, _, err = DeleteInstance().Delete("users").Where("name", Eq, Param("name")).SqlParams(params)
+	if !errors.Is(err, ErrUnknownParam) {
+		t.Fatalf("Expected ErrUnknownParam, got %v", err)
+	}
+}
+
+// TestParamIn tests the named parameters of IN and NOT IN conditions
+func TestParamIn(t *testing.T) {
+	query := QueryInstance().
+		SetDialect(new(PostgreSQLDialect)).
+		From("users").
+		Where("id", In, Param("ids")).
+		Where("role", NotIn, Param("roles"))
+
+	sql, args, err := query.SqlParams(map[string]any{"ids": []int{1, 2}, "roles": []string{"admin"}})
+
+	expected := "SELECT * FROM users WHERE id = ANY($1) AND role <> ALL($2)"
+	if err != nil || sql != expected {
+		t.Fatalf(`Query %s != %s (%v)`, sql, expected, err)
+	}
+
+	if fmt.Sprint(args) != "[[1 2] [admin]]" {
+		t.Fatalf("Unexpected arguments %v", args)
+	}
+
+	expected = "SELECT * FROM users WHERE id = ANY(:ids) AND role <> ALL(:roles)"
+	if query.String() != expected {
+		t.Fatalf(`Query %s != %s`, query.String(), expected)
+	}
+
+	// Other dialects have no array parameter
+	_, _, err = QueryInstance().SetDialect(new(MySQLDialect)).From("users").Where("id", In, Param("ids")).Sql()
+	if !errors.Is(err, ErrNotSupported) {
+		t.Fatalf("Expected ErrNotSupported, got %v", err)
+	}
+}
+
+// TestParamPaging
+func TestParamPaging(t *testing.T) {
+	testCases := []struct {
+		query    *QueryBuilder
+		expected string
+		args     string
+	}{
+		{
+			QueryInstance().SetDialect(new(SQLServerDialect)).Select("id").From("users").LimitParam("size", ""),
+			"SELECT TOP (@p1) id FROM users",
+			"[:size]",
+		},
+		{
+			QueryInstance().SetDialect(new(SQLServerDialect)).Select("id").From("users").LimitParam("size", "skip"),
+			"SELECT id FROM users ORDER BY (SELECT NULL) OFFSET @p1 ROWS FETCH NEXT @p2 ROWS ONLY",
+			"[:skip :size]",
+		},
+		{
+			QueryInstance().SetDialect(new(OracleDialect)).Select("id").From("users").LimitParam("size", ""),
+			"SELECT id FROM users FETCH FIRST :1 ROWS ONLY",
+			"[:size]",
+		},
+		{
+			QueryInstance().SetDialect(new(PostgreSQLDialect)).Select("id").From("users").Limit(10, 0).LimitParam("", "skip"),
+			"SELECT id FROM users LIMIT $1 OFFSET $2",
+			"[10 :skip]",
+		},
+		{
+			QueryInstance().SetDialect(new(PostgreSQLDialect)).Select("id").From("users").FetchParam("skip", "size"),
+			"SELECT id FROM users OFFSET $1 ROWS FETCH NEXT $2 ROWS ONLY",
+			"[:skip :size]",
+		},
+	}
+
+	for _, testCase := range testCases {
+		sql, args, err := testCase.query.Sql()
+		if err != nil || sql != testCase.expected {
+			t.Fatalf(`Query %s != %s (%v)`, sql, testCase.expected, err)
+		}
+
+		if fmt.Sprint(args) != testCase.args {
+			t.Fatalf("Arguments %v != %s", args, testCase.args)
+		}
+	}
+
+	query := QueryInstance().From("users").Where("email", Eq, Param("email")).LimitParam("size", "")
+	if query.String() != "SELECT * FROM users WHERE email = :email LIMIT :size OFFSET 0" {
+		t.Fatalf("Unexpected query %s", query.String())
+	}
+
+	if _, err := query.Interpolate(); !errors.Is(err, ErrMissingParam) {
+		t.Fatalf("Expected ErrMissingParam, got %v", err)
+	}
+}
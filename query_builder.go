@@ -1,7 +1,7 @@
 package fluentsql
 
 import (
-	"fmt"
+	"reflect"
 	"strings"
 )
 
@@ -46,9 +46,15 @@ import (
 //	 | INTO var_name [, var_name] ...
 //	}
 type QueryBuilder struct {
+	// dialect overrides the default dialect when set. Subqueries use the dialect of the enclosing statement.
+	dialect Dialect
+
 	// alias defines an optional alias for the query.
 	alias string
 
+	// withStatement represents the WITH clause (common table expressions) of the query.
+	withStatement With
+
 	// selectStatement represents the SELECT clause of the query.
 	selectStatement Select
 
@@ -67,14 +73,26 @@ type QueryBuilder struct {
 	// havingStatement represents the HAVING clause of the query.
 	havingStatement Having
 
+	// windowStatement represents the WINDOW clause (named windows) of the query.
+	windowStatement Window
+
 	// orderByStatement represents the ORDER BY clause of the query.
 	orderByStatement OrderBy
 
+	// limitByStatement represents the LIMIT BY clause (ClickHouse) of the query.
+	limitByStatement LimitBy
+
 	// limitStatement represents the LIMIT clause of the query.
 	limitStatement Limit
 
 	// fetchStatement represents a FETCH clause, an alternative to LIMIT.
 	fetchStatement Fetch
+
+	// lockStatement represents the row-locking clause (FOR UPDATE, FOR SHARE, ...) of the query.
+	lockStatement Lock
+
+	// settingsStatement represents the SETTINGS clause (ClickHouse) of the query.
+	settingsStatement Settings
 }
 
 // QueryInstance creates and returns a new instance of QueryBuilder.
@@ -94,68 +112,183 @@ func QueryInstance() *QueryBuilder {
 // Returns:
 // - string: The SQL query string representation of the QueryBuilder.
 func (qb *QueryBuilder) String() string {
+	return qb.render(qb.dialect)
+}
+
+// render generates the SQL of String with the dialect d, the dialect of the enclosing
+// statement for a subquery. The default dialect is used when d is nil.
+func (qb *QueryBuilder) render(d Dialect) string {
+	d = dialectOr(d)
+
 	var queryParts []string
 
+	// Append WITH clause
+	withSql := qb.withStatement.render(d)
+	if withSql != "" {
+		queryParts = append(queryParts, withSql)
+	}
+
+	// SQL Server limits rows with TOP unless an offset is set
+	top := qb.limitStatement.top(d)
+
+	limitSql := ""
+	if top == "" {
+		limitSql = qb.limitStatement.render(d)
+	}
+	fetchSql := qb.fetchStatement.String()
+
 	// Append SELECT clause
 	// Append FROM clause
 	queryParts = append(queryParts,
-		qb.selectStatement.String(),
-		qb.fromStatement.String(),
+		withTop(qb.selectStatement.render(d), qb.selectStatement.keyword(), top),
+		qb.fromStatement.render(d),
 	)
 
 	// Append JOIN clauses
-	joinSql := qb.joinStatement.String()
+	joinSql := qb.joinStatement.render(d)
 	if joinSql != "" {
 		queryParts = append(queryParts, joinSql)
 	}
 
 	// Append WHERE clause
-	whereSql := qb.whereStatement.String()
+	whereSql := qb.whereStatement.render(d)
 	if whereSql != "" {
 		queryParts = append(queryParts, whereSql)
 	}
 
 	// Append GROUP BY clause
-	groupSql := qb.groupByStatement.String()
+	groupSql := qb.groupByStatement.render(d)
 	if groupSql != "" {
 		queryParts = append(queryParts, groupSql)
 	}
 
 	// Append HAVING clause
-	havingSql := qb.havingStatement.String()
+	havingSql := qb.havingStatement.render(d)
 	if havingSql != "" {
 		queryParts = append(queryParts, havingSql)
 	}
 
+	// Append WINDOW clause
+	windowSql := qb.windowStatement.render(d)
+	if windowSql != "" {
+		queryParts = append(queryParts, windowSql)
+	}
+
 	// Append ORDER BY clause
-	orderBySql := qb.orderByStatement.String()
+	orderBySql := pagingOrderBy(d, qb.orderByStatement.render(d), limitSql != "" || fetchSql != "")
 	if orderBySql != "" {
 		queryParts = append(queryParts, orderBySql)
 	}
 
+	// Append LIMIT BY clause
+	limitBySql := qb.limitByStatement.render(d)
+	if limitBySql != "" {
+		queryParts = append(queryParts, limitBySql)
+	}
+
 	// Append LIMIT clause
-	limitSql := qb.limitStatement.String()
 	if limitSql != "" {
 		queryParts = append(queryParts, limitSql)
 	}
 
 	// Append FETCH clause
-	fetchSql := qb.fetchStatement.String()
 	if fetchSql != "" {
 		queryParts = append(queryParts, fetchSql)
 	}
 
+	// Append locking clause
+	lockSql := qb.lockStatement.render(d)
+	if lockSql != "" {
+		queryParts = append(queryParts, lockSql)
+	}
+
+	// Append SETTINGS clause
+	settingsSql := qb.settingsStatement.render(d)
+	if settingsSql != "" {
+		queryParts = append(queryParts, settingsSql)
+	}
+
 	// Join all parts with a space
 	sql := strings.Join(queryParts, " ")
 
 	// Add alias if provided
 	if qb.alias != "" {
-		sql = fmt.Sprintf("(%s) AS %s", sql, qb.alias)
+		sql = d.SubqueryAlias("("+sql+")", qb.alias)
 	}
 
 	return sql
 }
 
+// With adds a common table expression to the WITH clause of the query.
+//
+// Parameters:
+// - name string: The name of the CTE.
+// - query any: The body of the CTE, a *QueryBuilder or *CompoundBuilder.
+// - columns ...string: Optional column names of the CTE.
+//
+// Returns:
+// - *QueryBuilder: The QueryBuilder instance with updated WITH clause.
+//
+// Examples:
+//
+//	WITH regional_sales AS (SELECT region, SUM(amount) AS total_sales FROM orders GROUP BY region) SELECT * FROM regional_sales
+func (qb *QueryBuilder) With(name string, query any, columns ...string) *QueryBuilder {
+	qb.withStatement.Append(CTE{
+		Name:    name,
+		Columns: columns,
+		Query:   query,
+	})
+	return qb
+}
+
+// WithRecursive adds a common table expression and marks the WITH clause as RECURSIVE.
+// The keyword is omitted under SQL Server and Oracle, whose CTEs are recursive without it.
+//
+// Parameters:
+// - name string: The name of the CTE.
+// - query any: The body of the CTE, a *QueryBuilder or *CompoundBuilder.
+// - columns ...string: Optional column names of the CTE.
+//
+// Returns:
+// - *QueryBuilder: The QueryBuilder instance with updated WITH clause.
+func (qb *QueryBuilder) WithRecursive(name string, query any, columns ...string) *QueryBuilder {
+	qb.withStatement.Recursive = true
+	return qb.With(name, query, columns...)
+}
+
+// WithMaterialized adds a common table expression with a MATERIALIZED or NOT MATERIALIZED hint.
+// The hint is written under PostgreSQL and SQLite only, other dialects decide by themselves.
+//
+// Parameters:
+// - name string: The name of the CTE.
+// - materialized CTEMaterialized: The materialization hint.
+// - query any: The body of the CTE, a *QueryBuilder or *CompoundBuilder.
+// - columns ...string: Optional column names of the CTE.
+//
+// Returns:
+// - *QueryBuilder: The QueryBuilder instance with updated WITH clause.
+func (qb *QueryBuilder) WithMaterialized(name string, materialized CTEMaterialized, query any, columns ...string) *QueryBuilder {
+	qb.withStatement.Append(CTE{
+		Name:         name,
+		Columns:      columns,
+		Query:        query,
+		Materialized: materialized,
+	})
+	return qb
+}
+
+// WithCTE appends prepared common table expressions to the WITH clause.
+//
+// Parameters:
+// - ctes ...CTE: The CTEs to be added.
+//
+// Returns:
+// - *QueryBuilder: The QueryBuilder instance with updated WITH clause.
+func (qb *QueryBuilder) WithCTE(ctes ...CTE) *QueryBuilder {
+	qb.withStatement.Append(ctes...)
+	return qb
+}
+
 // Select defines the SELECT clause of the query.
 //
 // Parameters:
@@ -168,6 +301,85 @@ func (qb *QueryBuilder) Select(columns ...any) *QueryBuilder {
 	return qb
 }
 
+// SelectStruct sets the SELECT columns to the `db` tagged fields of a struct.
+// OmitZero does not apply: the columns depend on the struct type only.
+//
+// Parameters:
+// - v any: A struct or a pointer to a struct, e.g. (*User)(nil).
+// - opts ...StructOptions: Optional selection of the columns, e.g. StructOptions{Exclude: []string{"password"}}.
+//
+// Returns:
+// - *QueryBuilder: The QueryBuilder instance with updated SELECT clause.
+func (qb *QueryBuilder) SelectStruct(v any, opts ...StructOptions) *QueryBuilder {
+	_, meta := structOf(v)
+	if meta == nil {
+		return qb
+	}
+
+	var columns []any
+	for _, field := range structFields(reflect.Value{}, meta, structOptions(opts)) {
+		columns = append(columns, field.Name)
+	}
+
+	return qb.Select(columns...)
+}
+
+// SelectStruct sets the SELECT columns of a QueryBuilder to the `db` tagged fields of the struct type T.
+// It is a function because Go methods cannot have type parameters.
+//
+// Parameters:
+// - qb *QueryBuilder: The QueryBuilder instance.
+// - opts ...StructOptions: Optional selection of the columns.
+//
+// Returns:
+// - *QueryBuilder: The QueryBuilder instance with updated SELECT clause.
+//
+// Examples:
+//
+//	SelectStruct[User](QueryInstance()).From("users").Where("id", Eq, 1)
+func SelectStruct[T any](qb *QueryBuilder, opts ...StructOptions) *QueryBuilder {
+	return qb.SelectStruct((*T)(nil), opts...)
+}
+
+// Distinct removes duplicate rows from the result (SELECT DISTINCT).
+//
+// Returns:
+// - *QueryBuilder: The QueryBuilder instance with updated SELECT clause.
+func (qb *QueryBuilder) Distinct() *QueryBuilder {
+	qb.selectStatement.Distinct = true
+	return qb
+}
+
+// DistinctOn keeps only the first row of each set of rows where the expressions are equal.
+// DISTINCT ON is supported by PostgreSQL and ClickHouse; Sql returns ErrNotSupported under other dialects.
+//
+// Parameters:
+// - fields ...string: The expressions to compare.
+//
+// Returns:
+// - *QueryBuilder: The QueryBuilder instance with updated SELECT clause.
+//
+// Examples:
+//
+//	SELECT DISTINCT ON (department_id) department_id, first_name FROM employees ORDER BY department_id ASC, salary DESC
+func (qb *QueryBuilder) DistinctOn(fields ...string) *QueryBuilder {
+	qb.selectStatement.DistinctOn = append(qb.selectStatement.DistinctOn, fields...)
+	return qb
+}
+
+// Modifier adds MySQL select modifiers (HIGH_PRIORITY, STRAIGHT_JOIN, SQL_NO_CACHE, SQL_CALC_FOUND_ROWS, ...).
+// Sql returns ErrNotSupported under other dialects.
+//
+// Parameters:
+// - modifiers ...SelectModifier: The modifiers to add.
+//
+// Returns:
+// - *QueryBuilder: The QueryBuilder instance with updated SELECT clause.
+func (qb *QueryBuilder) Modifier(modifiers ...SelectModifier) *QueryBuilder {
+	qb.selectStatement.Modifiers = append(qb.selectStatement.Modifiers, modifiers...)
+	return qb
+}
+
 // From defines the FROM clause in the query.
 //
 // Parameters:
@@ -187,16 +399,37 @@ func (qb *QueryBuilder) From(table any, alias ...string) *QueryBuilder {
 	return qb
 }
 
+// Final merges the rows of a ClickHouse MergeTree table before reading them (FROM table FINAL).
+//
+// Returns:
+// - *QueryBuilder: The QueryBuilder instance with updated FROM clause.
+func (qb *QueryBuilder) Final() *QueryBuilder {
+	qb.fromStatement.Final = true
+	return qb
+}
+
+// Sample reads a sample of a ClickHouse table (FROM table SAMPLE k).
+//
+// Parameters:
+// - sample string: The sample, e.g. "0.1", "10000000" or "1/10 OFFSET 1/2".
+//
+// Returns:
+// - *QueryBuilder: The QueryBuilder instance with updated FROM clause.
+func (qb *QueryBuilder) Sample(sample string) *QueryBuilder {
+	qb.fromStatement.Sample = sample
+	return qb
+}
+
 // Join adds a join clause to the query.
 //
 // Parameters:
 // - join JoinType: The type of join (e.g., INNER JOIN, LEFT JOIN).
-// - table string: The table to join.
+// - table any: The table to join, a string, an Ident or a nested query with its alias set by AS.
 // - condition Condition: The ON condition for the join.
 //
 // Returns:
 // - *QueryBuilder: The QueryBuilder instance with the added JOIN clause.
-func (qb *QueryBuilder) Join(join JoinType, table string, condition Condition) *QueryBuilder {
+func (qb *QueryBuilder) Join(join JoinType, table any, condition Condition) *QueryBuilder {
 	qb.joinStatement.Append(JoinItem{
 		Join:      join,
 		Table:     table,
@@ -205,6 +438,127 @@ func (qb *QueryBuilder) Join(join JoinType, table string, condition Condition) *
 	return qb
 }
 
+// JoinOn adds a JOIN clause whose ON conditions are built like a WHERE clause, joined with AND or with OR.
+//
+// Parameters:
+// - join JoinType: The type of join (e.g., InnerJoin, LeftJoin).
+// - table any: The table to join, a string, an Ident or a nested query with its alias set by AS.
+// - on FnWhereBuilder: The function building the ON conditions.
+//
+// Returns:
+// - *QueryBuilder: The QueryBuilder instance with the added JOIN clause.
+//
+// Examples:
+//
+//	SELECT * FROM users u LEFT JOIN orders o ON o.user_id = u.id AND o.status = $1
+func (qb *QueryBuilder) JoinOn(join JoinType, table any, on FnWhereBuilder) *QueryBuilder {
+	qb.joinStatement.Append(JoinItem{
+		Join:  join,
+		Table: table,
+		On:    on(*WhereInstance()).whereStatement.Conditions,
+	})
+	return qb
+}
+
+// JoinUsing adds a JOIN clause matching the rows on the columns of the same name in both tables (USING).
+// Sql returns ErrNotSupported for a CROSS JOIN or an ARRAY JOIN.
+//
+// Parameters:
+// - join JoinType: The type of join (e.g., InnerJoin, LeftJoin).
+// - table any: The table to join, a string, an Ident or a nested query with its alias set by AS.
+// - columns ...string: The columns of the USING clause.
+//
+// Returns:
+// - *QueryBuilder: The QueryBuilder instance with the added JOIN clause.
+func (qb *QueryBuilder) JoinUsing(join JoinType, table any, columns ...string) *QueryBuilder {
+	qb.joinStatement.Append(JoinItem{
+		Join:  join,
+		Table: table,
+		Using: columns,
+	})
+	return qb
+}
+
+// NaturalJoin adds a NATURAL JOIN clause matching the rows on all the columns of the same name in both tables.
+// Sql returns ErrNotSupported for a CROSS JOIN or an ARRAY JOIN.
+//
+// Parameters:
+// - join JoinType: The type of join (e.g., InnerJoin, LeftJoin).
+// - table any: The table to join, a string, an Ident or a nested query with its alias set by AS.
+//
+// Returns:
+// - *QueryBuilder: The QueryBuilder instance with the added JOIN clause.
+func (qb *QueryBuilder) NaturalJoin(join JoinType, table any) *QueryBuilder {
+	qb.joinStatement.Append(JoinItem{
+		Join:    join,
+		Table:   table,
+		Natural: true,
+	})
+	return qb
+}
+
+// JoinLateral adds a LATERAL JOIN clause, whose query may refer to the columns of the preceding tables.
+// Without ON conditions, the join is written "ON true", or as a CROSS APPLY or OUTER APPLY on SQL Server and Oracle.
+//
+// Parameters:
+// - join JoinType: The type of join (e.g., InnerJoin, LeftJoin, CrossJoin).
+// - query any: The query to join with its alias set by AS, a *QueryBuilder or an Expr.
+// - on FnWhereBuilder: The function building the ON conditions, nil for none.
+//
+// Returns:
+// - *QueryBuilder: The QueryBuilder instance with the added JOIN clause.
+//
+// Examples:
+//
+//	SELECT u.id, o.total FROM users u LEFT JOIN LATERAL (SELECT total FROM orders WHERE user_id = u.id
+//	ORDER BY created_at DESC LIMIT 1) AS o ON true
+func (qb *QueryBuilder) JoinLateral(join JoinType, query any, on FnWhereBuilder) *QueryBuilder {
+	item := JoinItem{
+		Join:    join,
+		Table:   query,
+		Lateral: true,
+	}
+
+	if on != nil {
+		item.On = on(*WhereInstance()).whereStatement.Conditions
+	}
+
+	qb.joinStatement.Append(item)
+	return qb
+}
+
+// ArrayJoin unfolds an array column into rows (ClickHouse ARRAY JOIN).
+// The rows with an empty array are dropped, use LeftArrayJoin to keep them.
+//
+// Parameters:
+// - expr string: The array expression, with an optional alias (e.g. "tags AS tag").
+//
+// Returns:
+// - *QueryBuilder: The QueryBuilder instance with the added ARRAY JOIN clause.
+func (qb *QueryBuilder) ArrayJoin(expr string) *QueryBuilder {
+	qb.joinStatement.Append(JoinItem{
+		Join:  ArrayJoin,
+		Table: expr,
+	})
+	return qb
+}
+
+// LeftArrayJoin unfolds an array column into rows, keeping the rows with an empty array
+// (ClickHouse LEFT ARRAY JOIN).
+//
+// Parameters:
+// - expr string: The array expression, with an optional alias (e.g. "tags AS tag").
+//
+// Returns:
+// - *QueryBuilder: The QueryBuilder instance with the added LEFT ARRAY JOIN clause.
+func (qb *QueryBuilder) LeftArrayJoin(expr string) *QueryBuilder {
+	qb.joinStatement.Append(JoinItem{
+		Join:  LeftArrayJoin,
+		Table: expr,
+	})
+	return qb
+}
+
 // Having defines the HAVING clause of the query.
 //
 // Parameters:
@@ -297,28 +651,74 @@ func (qb *QueryBuilder) WhereCondition(conditions ...Condition) *QueryBuilder {
 // GroupBy defines the GROUP BY clause of the query.
 //
 // Parameters:
-// - fields ...string: The fields to group by.
+// - fields ...any: The fields to group by, strings, Idents, expressions, or grouping sets created by Rollup,
+// Cube or GroupingSets.
 //
 // Returns:
 // - *QueryBuilder: The QueryBuilder instance with updated GROUP BY clause.
-func (qb *QueryBuilder) GroupBy(fields ...string) *QueryBuilder {
+func (qb *QueryBuilder) GroupBy(fields ...any) *QueryBuilder {
 	qb.groupByStatement.Append(fields...)
 	return qb
 }
 
+// Window declares a named window in the WINDOW clause of the query.
+//
+// Parameters:
+// - name string: The name of the window, referenced by WindowFunction.OverWindow.
+// - spec *WindowSpec: The window specification.
+//
+// Returns:
+// - *QueryBuilder: The QueryBuilder instance with updated WINDOW clause.
+//
+// Examples:
+//
+//	SELECT RANK() OVER w AS r FROM employees WINDOW w AS (PARTITION BY department_id ORDER BY salary DESC)
+func (qb *QueryBuilder) Window(name string, spec *WindowSpec) *QueryBuilder {
+	qb.windowStatement.Append(name, *spec)
+	return qb
+}
+
 // OrderBy defines the ORDER BY clause of the query.
 //
 // Parameters:
-// - field string: The field to sort by.
+// - field any: The field to sort by, a string, an Ident, an expression or the position of a select column.
 // - dir OrderByDir: The direction of sorting (ASC or DESC).
 //
 // Returns:
 // - *QueryBuilder: The QueryBuilder instance with updated ORDER BY clause.
-func (qb *QueryBuilder) OrderBy(field string, dir OrderByDir) *QueryBuilder {
+func (qb *QueryBuilder) OrderBy(field any, dir OrderByDir) *QueryBuilder {
 	qb.orderByStatement.Append(field, dir)
 	return qb
 }
 
+// OrderBySort appends sort items with a NULLS position or a collation to the ORDER BY clause.
+//
+// Parameters:
+// - items ...SortItem: The sort items, e.g. Sort("created_at", Desc).NullsLast().
+//
+// Returns:
+// - *QueryBuilder: The QueryBuilder instance with updated ORDER BY clause.
+func (qb *QueryBuilder) OrderBySort(items ...SortItem) *QueryBuilder {
+	qb.orderByStatement.Items = append(qb.orderByStatement.Items, items...)
+	return qb
+}
+
+// LimitBy sets the LIMIT BY clause of the query, which keeps the first rows of each group (ClickHouse).
+//
+// Parameters:
+// - limit int: The maximum number of rows of each group.
+// - offset int: The number of rows of each group to skip.
+// - by ...string: The expressions defining the groups.
+//
+// Returns:
+// - *QueryBuilder: The QueryBuilder instance with updated LIMIT BY clause.
+func (qb *QueryBuilder) LimitBy(limit, offset int, by ...string) *QueryBuilder {
+	qb.limitByStatement.Limit = limit
+	qb.limitByStatement.Offset = offset
+	qb.limitByStatement.By = by
+	return qb
+}
+
 // Limit sets the LIMIT clause of the query.
 //
 // Parameters:
@@ -333,6 +733,20 @@ func (qb *QueryBuilder) Limit(limit, offset int) *QueryBuilder {
 	return qb
 }
 
+// LimitParam sets the LIMIT clause with named parameters, bound when the statement is executed.
+//
+// Parameters:
+// - limit Param: The parameter of the maximum number of rows to return.
+// - offset Param: The parameter of the number of rows to skip, or an empty Param for no offset.
+//
+// Returns:
+// - *QueryBuilder: The QueryBuilder instance with updated LIMIT clause.
+func (qb *QueryBuilder) LimitParam(limit, offset Param) *QueryBuilder {
+	qb.limitStatement.LimitParam = limit
+	qb.limitStatement.OffsetParam = offset
+	return qb
+}
+
 // RemoveLimit removes the LIMIT clause from the query.
 //
 // Returns:
@@ -342,9 +756,13 @@ func (qb *QueryBuilder) RemoveLimit() Limit {
 
 	_limitStatement.Limit = qb.limitStatement.Limit
 	_limitStatement.Offset = qb.limitStatement.Offset
+	_limitStatement.LimitParam = qb.limitStatement.LimitParam
+	_limitStatement.OffsetParam = qb.limitStatement.OffsetParam
 
 	qb.limitStatement.Limit = 0
 	qb.limitStatement.Offset = 0
+	qb.limitStatement.LimitParam = ""
+	qb.limitStatement.OffsetParam = ""
 
 	return _limitStatement
 }
@@ -363,6 +781,20 @@ func (qb *QueryBuilder) Fetch(offset, fetch int) *QueryBuilder {
 	return qb
 }
 
+// FetchParam sets the FETCH clause with named parameters, bound when the statement is executed.
+//
+// Parameters:
+// - offset Param: The parameter of the number of rows to skip, or an empty Param for no offset.
+// - fetch Param: The parameter of the number of rows to fetch.
+//
+// Returns:
+// - *QueryBuilder: The QueryBuilder instance with updated FETCH clause.
+func (qb *QueryBuilder) FetchParam(offset, fetch Param) *QueryBuilder {
+	qb.fetchStatement.OffsetParam = offset
+	qb.fetchStatement.FetchParam = fetch
+	return qb
+}
+
 // RemoveFetch removes the FETCH clause from the query.
 //
 // Returns:
@@ -372,13 +804,89 @@ func (qb *QueryBuilder) RemoveFetch() Fetch {
 
 	_fetchStatement.Offset = qb.fetchStatement.Offset
 	_fetchStatement.Fetch = qb.fetchStatement.Fetch
+	_fetchStatement.OffsetParam = qb.fetchStatement.OffsetParam
+	_fetchStatement.FetchParam = qb.fetchStatement.FetchParam
 
 	qb.fetchStatement.Offset = 0
 	qb.fetchStatement.Fetch = 0
+	qb.fetchStatement.OffsetParam = ""
+	qb.fetchStatement.FetchParam = ""
 
 	return _fetchStatement
 }
 
+// Lock sets the row-locking clause of the query.
+//
+// Parameters:
+// - strength LockStrength: The lock strength (e.g., LockForUpdate, LockForShare).
+// - of ...string: Optional tables the lock is restricted to.
+//
+// Returns:
+// - *QueryBuilder: The QueryBuilder instance with updated locking clause.
+//
+// Examples:
+//
+//	SELECT id FROM jobs WHERE status = 'pending' LIMIT 10 OFFSET 0 FOR UPDATE SKIP LOCKED
+func (qb *QueryBuilder) Lock(strength LockStrength, of ...string) *QueryBuilder {
+	qb.lockStatement.Strength = strength
+	qb.lockStatement.Of = of
+	return qb
+}
+
+// ForUpdate locks the selected rows with FOR UPDATE.
+//
+// Parameters:
+// - of ...string: Optional tables the lock is restricted to.
+//
+// Returns:
+// - *QueryBuilder: The QueryBuilder instance with updated locking clause.
+func (qb *QueryBuilder) ForUpdate(of ...string) *QueryBuilder {
+	return qb.Lock(LockForUpdate, of...)
+}
+
+// ForShare locks the selected rows with FOR SHARE (LOCK IN SHARE MODE on MySQL).
+//
+// Parameters:
+// - of ...string: Optional tables the lock is restricted to.
+//
+// Returns:
+// - *QueryBuilder: The QueryBuilder instance with updated locking clause.
+func (qb *QueryBuilder) ForShare(of ...string) *QueryBuilder {
+	return qb.Lock(LockForShare, of...)
+}
+
+// NoWait makes the locking clause fail instead of waiting for locked rows (NOWAIT).
+//
+// Returns:
+// - *QueryBuilder: The QueryBuilder instance with updated locking clause.
+func (qb *QueryBuilder) NoWait() *QueryBuilder {
+	qb.lockStatement.Wait = LockNoWait
+	return qb
+}
+
+// SkipLocked makes the locking clause skip rows locked by other transactions (SKIP LOCKED).
+//
+// Returns:
+// - *QueryBuilder: The QueryBuilder instance with updated locking clause.
+func (qb *QueryBuilder) SkipLocked() *QueryBuilder {
+	qb.lockStatement.Wait = LockSkipLocked
+	return qb
+}
+
+// Settings adds a query-level setting to the SETTINGS clause (ClickHouse).
+// Sql returns ErrUnsafeIdent when the name is not a plain identifier, as the name is written as is.
+//
+// Parameters:
+// - key string: The name of the setting, e.g. "max_threads".
+// - value any: The value of the setting, a string, number or bool.
+//
+// Returns:
+// - *QueryBuilder: The QueryBuilder instance with updated SETTINGS clause.
+func (qb *QueryBuilder) Settings(key string, value any) *QueryBuilder {
+	qb.settingsStatement.Append(key, value)
+	return qb
+}
+
 // AS sets an alias for the entire QueryBuilder instance.
 //
 // Parameters:
@@ -395,3 +903,17 @@ func (qb *QueryBuilder) AS(alias string) *QueryBuilder {
 	qb.alias = alias
 	return qb
 }
+
+// SetDialect sets the dialect used to generate the query instead of the default dialect.
+// Subqueries, CTEs and combined queries are generated with the same dialect.
+// Sql returns ErrNotSupported for one with a different dialect of its own.
+//
+// Parameters:
+// - dialect Dialect: The database dialect, e.g. new(SQLiteDialect).
+//
+// Returns:
+// - *QueryBuilder: The QueryBuilder instance with the dialect set.
+func (qb *QueryBuilder) SetDialect(dialect Dialect) *QueryBuilder {
+	qb.dialect = dialect
+	return qb
+}
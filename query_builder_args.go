@@ -2,7 +2,6 @@ package fluentsql
 
 import (
 	"fmt"
-	"reflect"
 	"strings"
 )
 
@@ -19,6 +18,53 @@ func (qb *QueryBuilder) Sql() (string, []any, error) {
 	return qb.StringArgs(args)
 }
 
+// Interpolate generates the query of Sql with its arguments inlined as literals escaped for the dialect,
+// e.g. for logging or debugging. Execute the statement of Sql with its arguments instead.
+//
+// Returns:
+// - string: The query without placeholders.
+// - error: The error of Sql, or an error when an argument cannot be written as a literal.
+func (qb *QueryBuilder) Interpolate() (string, error) {
+	sql, args, err := qb.Sql()
+	if err != nil {
+		return "", err
+	}
+
+	return interpolate(qb.dialect, sql, args)
+}
+
+// Compile generates the query once, for executions binding its named parameters with Bind.
+//
+// Returns:
+// - *Compiled: The compiled query.
+// - error: The error of Sql.
+func (qb *QueryBuilder) Compile() (*Compiled, error) {
+	return compile(qb.Sql())
+}
+
+// SqlParams generates the query of Sql with its named parameters bound, see Compiled.Bind.
+//
+// Parameters:
+// - params any: The values of the parameters, a map[string]any or a tagged struct.
+//
+// Returns:
+// - string: The query with placeholders.
+// - []any: The arguments of the query.
+// - error: The error of Sql, or of binding the parameters.
+func (qb *QueryBuilder) SqlParams(params any) (string, []any, error) {
+	compiled, err := qb.Compile()
+	if err != nil {
+		return "", nil, err
+	}
+
+	args, err := compiled.Bind(params)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return compiled.Sql(), args, nil
+}
+
 // StringArgs constructs the SQL query string with placeholders and its associated arguments.
 //
 // Parameters:
@@ -29,46 +75,155 @@ func (qb *QueryBuilder) Sql() (string, []any, error) {
 // - []any: A slice containing all arguments for the query.
 // - error: Any error encountered during query string construction.
 func (qb *QueryBuilder) StringArgs(args []any) (string, []any, error) {
+	if isStrict(qb.dialect) {
+		if err := qb.checkIdents(); err != nil {
+			return "", args, err
+		}
+	}
+
+	return qb.renderArgs(qb.dialect, args)
+}
+
+// renderArgs generates the SQL of StringArgs with the dialect d, the dialect of the enclosing
+// statement for a subquery. The default dialect is used when d is nil.
+func (qb *QueryBuilder) renderArgs(d Dialect, args []any) (string, []any, error) {
+	if err := checkDialect(qb.dialect, d); err != nil {
+		return "", args, err
+	}
+
+	d = dialectOr(d)
+
 	var queryParts []string // Slice to hold the parts of the query
 	var sqlStr string       // Variable to store the current query part
 
-	sqlStr, args = qb.selectStatement.StringArgs(args)
+	// Check the SELECT options against the dialect
+	if err := qb.selectStatement.validate(d); err != nil {
+		return "", args, err
+	}
+
+	// Check the table modifiers and the join types against the dialect
+	if err := qb.fromStatement.validate(d); err != nil {
+		return "", args, err
+	}
+
+	if err := qb.joinStatement.validate(d, &qb.settingsStatement); err != nil {
+		return "", args, err
+	}
+
+	if err := qb.groupByStatement.validate(d); err != nil {
+		return "", args, err
+	}
+
+	sqlStr, args, err := qb.withStatement.renderArgs(d, args)
+	if err != nil {
+		return "", args, err
+	}
+	if sqlStr != "" {
+		queryParts = append(queryParts, sqlStr)
+	}
+
+	// SQL Server limits rows with TOP unless an offset is set
+	top := qb.limitStatement.top(d)
+	paged := (top == "" && qb.limitStatement.render(d) != "") || qb.fetchStatement.String() != ""
+
+	sqlStr, args, err = qb.selectStatement.renderArgs(d, args)
+	if err != nil {
+		return "", args, err
+	}
+	selectIndex := len(queryParts)
 	queryParts = append(queryParts, sqlStr)
 
-	sqlStr, args = qb.fromStatement.StringArgs(args)
+	sqlStr, args, err = qb.fromStatement.renderArgs(d, args)
+	if err != nil {
+		return "", args, err
+	}
 	queryParts = append(queryParts, sqlStr)
 
-	sqlStr, args = qb.joinStatement.StringArgs(args)
+	sqlStr, args, err = qb.joinStatement.renderArgs(d, args)
+	if err != nil {
+		return "", args, err
+	}
 	if sqlStr != "" {
 		queryParts = append(queryParts, sqlStr)
 	}
 
-	sqlStr, args = qb.whereStatement.StringArgs(args)
+	sqlStr, args, err = qb.whereStatement.renderArgs(d, args)
+	if err != nil {
+		return "", args, err
+	}
+	if sqlStr != "" {
+		queryParts = append(queryParts, sqlStr)
+	}
+
+	sqlStr, args, err = qb.groupByStatement.renderArgs(d, args)
+	if err != nil {
+		return "", args, err
+	}
+	if sqlStr != "" {
+		queryParts = append(queryParts, sqlStr)
+	}
+
+	sqlStr, args, err = qb.havingStatement.renderArgs(d, args)
+	if err != nil {
+		return "", args, err
+	}
 	if sqlStr != "" {
 		queryParts = append(queryParts, sqlStr)
 	}
 
-	sqlStr, args = qb.groupByStatement.StringArgs(args)
+	sqlStr, args, err = qb.windowStatement.renderArgs(d, args)
+	if err != nil {
+		return "", args, err
+	}
 	if sqlStr != "" {
 		queryParts = append(queryParts, sqlStr)
 	}
 
-	sqlStr, args = qb.havingStatement.StringArgs(args)
+	sqlStr, args, err = qb.orderByStatement.renderArgs(d, args)
+	if err != nil {
+		return "", args, err
+	}
+	sqlStr = pagingOrderBy(d, sqlStr, paged)
 	if sqlStr != "" {
 		queryParts = append(queryParts, sqlStr)
 	}
 
-	sqlStr, args = qb.orderByStatement.StringArgs(args)
+	sqlStr, args, err = qb.limitByStatement.renderArgs(d, args)
+	if err != nil {
+		return "", args, err
+	}
 	if sqlStr != "" {
 		queryParts = append(queryParts, sqlStr)
 	}
 
-	sqlStr, args = qb.limitStatement.StringArgs(args)
+	if top != "" {
+		// The row count of TOP is bound here, after the values of the preceding clauses.
+		top, args = qb.limitStatement.topArgs(d, args)
+		queryParts[selectIndex] = withTop(queryParts[selectIndex], qb.selectStatement.keyword(), top)
+	} else {
+		sqlStr, args = qb.limitStatement.renderArgs(d, args)
+		if sqlStr != "" {
+			queryParts = append(queryParts, sqlStr)
+		}
+	}
+
+	sqlStr, args = qb.fetchStatement.renderArgs(d, args)
+	if sqlStr != "" {
+		queryParts = append(queryParts, sqlStr)
+	}
+
+	sqlStr, args, err = qb.lockStatement.renderArgs(d, args)
+	if err != nil {
+		return "", args, err
+	}
 	if sqlStr != "" {
 		queryParts = append(queryParts, sqlStr)
 	}
 
-	sqlStr, args = qb.fetchStatement.StringArgs(args)
+	sqlStr, args, err = qb.settingsStatement.renderArgs(d, args)
+	if err != nil {
+		return "", args, err
+	}
 	if sqlStr != "" {
 		queryParts = append(queryParts, sqlStr)
 	}
@@ -76,9 +231,7 @@ func (qb *QueryBuilder) StringArgs(args []any) (string, []any, error) {
 	sqlStr = strings.Join(queryParts, " ") // Combine all query parts into a single string
 
 	if qb.alias != "" {
-		sqlStr = fmt.Sprintf("(%s) AS %s",
-			sqlStr,
-			qb.alias)
+		sqlStr = d.SubqueryAlias("("+sqlStr+")", qb.alias)
 	}
 
 	return sqlStr, args, nil
@@ -93,6 +246,13 @@ func (qb *QueryBuilder) StringArgs(args []any) (string, []any, error) {
 // - string: The complete SQL SELECT statement as a string.
 // - []any: A slice containing the arguments used in the query.
 func (s *Select) StringArgs(args []any) (string, []any) {
+	sql, args, _ := s.renderArgs(nil, args)
+
+	return sql, args
+}
+
+// renderArgs generates the SQL of StringArgs with the dialect d, the default dialect when nil.
+func (s *Select) renderArgs(d Dialect, args []any) (string, []any, error) {
 	selectOf := "*" // Default to selecting all columns
 
 	if len(s.Columns) > 0 {
@@ -101,24 +261,43 @@ func (s *Select) StringArgs(args []any) (string, []any) {
 		// Iterate through each column to process its type and generate the corresponding SQL part
 		for _, col := range s.Columns {
 			var sqlPart string
+			var err error
 			if _, ok := col.(*Case); ok { // Column is of type Case
-				sqlPart, args = col.(*Case).StringArgs(args)
-				columns = append(columns, sqlPart)
+				sqlPart, args, err = col.(*Case).renderArgs(d, args)
 			} else if valueString, ok := col.(string); ok { // Column is a plain string
-				columns = append(columns, valueString)
+				sqlPart = valueString
 			} else if valueFieldYear, ok := col.(FieldYear); ok { // Column is of type FieldYear
-				columns = append(columns, valueFieldYear.String())
+				sqlPart = valueFieldYear.render(d)
+			} else if valueWindow, ok := col.(*WindowFunction); ok { // Column is a window function
+				sqlPart, args, err = valueWindow.renderArgs(d, args)
+			} else if valueAggregate, ok := col.(*Aggregate); ok { // Column is an aggregate, with its alias
+				sqlPart, args, err = valueAggregate.renderArgs(d, args)
+				sqlPart += valueAggregate.alias()
 			} else if valueQueryBuilder, ok := col.(*QueryBuilder); ok { // Column is a QueryBuilder
-				var selectQuery string
-				selectQuery, args, _ = valueQueryBuilder.StringArgs(args)
+				sqlPart, args, err = valueQueryBuilder.renderArgs(d, args)
 
 				// Wrap the query in parentheses if no alias is provided
-				if col.(*QueryBuilder).alias == "" {
-					selectQuery = fmt.Sprintf("(%s)", selectQuery)
+				if valueQueryBuilder.alias == "" {
+					sqlPart = fmt.Sprintf("(%s)", sqlPart)
 				}
+			} else if valueCompound, ok := col.(*CompoundBuilder); ok { // Column is a CompoundBuilder
+				sqlPart, args, err = valueCompound.renderArgs(d, args)
 
-				columns = append(columns, selectQuery)
+				// Wrap the query in parentheses if no alias is provided
+				if valueCompound.alias == "" {
+					sqlPart = fmt.Sprintf("(%s)", sqlPart)
+				}
+			} else if valueExpr, ok := col.(Expr); ok { // Column is an expression, e.g. an Ident
+				sqlPart, args, err = valueExpr.renderArgs(d, args)
+			} else {
+				continue
+			}
+
+			if err != nil {
+				return "", args, err
 			}
+
+			columns = append(columns, sqlPart)
 		}
 
 		// Combine all column representations into a comma-separated string
@@ -126,7 +305,7 @@ func (s *Select) StringArgs(args []any) (string, []any) {
 	}
 
 	// Return the constructed SELECT statement and associated arguments
-	return fmt.Sprintf("SELECT %s", selectOf), args
+	return fmt.Sprintf("%s %s", s.keyword(), selectOf), args, nil
 }
 
 // StringArgs generates the SQL FROM clause string and associated arguments.
@@ -138,21 +317,25 @@ func (s *Select) StringArgs(args []any) (string, []any) {
 // - string: The SQL FROM clause string.
 // - []any: A slice containing the arguments used in the clause.
 func (f *From) StringArgs(args []any) (string, []any) {
+	sql, args, _ := f.renderArgs(nil, args)
+
+	return sql, args
+}
+
+// renderArgs generates the SQL of StringArgs with the dialect d, the default dialect when nil.
+func (f *From) renderArgs(d Dialect, args []any) (string, []any, error) {
 	var sb strings.Builder // String builder for constructing the FROM clause
 
-	// Process the table source based on its type
-	if _, ok := f.Table.(string); ok { // Table is a plain string
-		sb.WriteString(fmt.Sprintf("FROM %s", f.Table))
-	} else if _, ok := f.Table.(*QueryBuilder); ok { // Table is a QueryBuilder
-		var selectQuery string
-		selectQuery, args, _ = f.Table.(*QueryBuilder).StringArgs(args)
-
-		// Wrap the query in parentheses if no alias is provided
-		if f.Table.(*QueryBuilder).alias == "" {
-			sb.WriteString(fmt.Sprintf("FROM (%s)", selectQuery))
-		} else {
-			sb.WriteString(fmt.Sprintf("FROM %s", selectQuery))
+	if f.Table != nil {
+		var tableStr string
+		var err error
+
+		tableStr, args, err = tableSqlArgs(d, f.Table, args)
+		if err != nil {
+			return "", args, err
 		}
+
+		sb.WriteString("FROM " + tableStr)
 	}
 
 	// Append the table alias if provided
@@ -160,8 +343,57 @@ func (f *From) StringArgs(args []any) (string, []any) {
 		sb.WriteString(" " + f.Alias)
 	}
 
+	// Append the ClickHouse modifiers
+	sb.WriteString(f.modifiers(d))
+
 	// Return the constructed FROM clause and associated arguments
-	return sb.String(), args
+	return sb.String(), args, nil
+}
+
+// tableSqlArgs generates the table of a FROM or JOIN clause and appends the parameters of an expression
+// or a nested query to the arguments slice.
+//
+// Parameters:
+// - d Dialect: The dialect of the builder, the default dialect when nil.
+// - table any: A table name, an Ident, an expression or a nested query.
+// - args []any: The input slice of arguments.
+//
+// Returns:
+// - string: The SQL of the table.
+// - []any: The updated slice of arguments.
+// - error: The error of the expression or the nested query.
+func tableSqlArgs(d Dialect, table any, args []any) (string, []any, error) {
+	var selectQuery string
+	var alias string
+	var err error
+
+	switch value := table.(type) {
+	case string:
+		return value, args, nil
+	case Ident: // Quoted by the dialect
+		return value.render(d), args, nil
+	case *QueryBuilder:
+		selectQuery, args, err = value.renderArgs(d, args)
+		alias = value.alias
+	case *CompoundBuilder:
+		selectQuery, args, err = value.renderArgs(d, args)
+		alias = value.alias
+	case Expr: // E.g. a raw fragment
+		return value.renderArgs(d, args)
+	default:
+		return "", args, nil
+	}
+
+	if err != nil {
+		return "", args, err
+	}
+
+	// Wrap the query in parentheses if no alias is provided
+	if alias == "" {
+		return "(" + selectQuery + ")", args, nil
+	}
+
+	return selectQuery, args, nil
 }
 
 // StringArgs generates the SQL JOIN clause string and associated arguments.
@@ -173,31 +405,40 @@ func (f *From) StringArgs(args []any) (string, []any) {
 // - string: The SQL JOIN clause string. Returns an empty string if there are no JOIN items.
 // - []any: A slice containing the arguments used in the clause.
 func (j *Join) StringArgs(args []any) (string, []any) {
+	sql, args, _ := j.renderArgs(nil, args)
+
+	return sql, args
+}
+
+// renderArgs generates the SQL of StringArgs with the dialect d, the default dialect when nil.
+func (j *Join) renderArgs(d Dialect, args []any) (string, []any, error) {
 	// Return empty string if there are no join items
 	if len(j.Items) == 0 {
-		return "", args
+		return "", args, nil
 	}
 
 	var joinItems []string // Slice to hold each join statement
 
 	// Process each join item to generate the full join statement
 	for _, item := range j.Items {
-		var cond string
-		cond, args = item.Condition.StringArgs(args)
+		// ARRAY JOIN is omitted outside ClickHouse, validate reports it instead
+		if item.isArrayJoin() && !isDialect(d, ClickHouse) {
+			continue
+		}
 
-		// Construct the join string based on the type of JOIN
-		joinStr := fmt.Sprintf("%s %s ON %s", item.opt(), item.Table, cond)
+		var joinStr string
+		var err error
 
-		// For CROSS JOIN, omit the ON clause
-		if item.Join == CrossJoin {
-			joinStr = fmt.Sprintf("%s %s", item.opt(), item.Table)
+		joinStr, args, err = item.build(d, args, true)
+		if err != nil {
+			return "", args, err
 		}
 
 		joinItems = append(joinItems, joinStr)
 	}
 
 	// Combine all join statements into a single string and return
-	return strings.Join(joinItems, " "), args
+	return strings.Join(joinItems, " "), args, nil
 }
 
 // StringArgs generates the SQL WHERE clause string and associated arguments.
@@ -209,13 +450,25 @@ func (j *Join) StringArgs(args []any) (string, []any) {
 // - string: The complete SQL WHERE clause string. Returns an empty string if no conditions are present.
 // - []any: A slice containing the arguments used in the WHERE clause.
 func (w *Where) StringArgs(args []any) (string, []any) {
+	sql, args, _ := w.renderArgs(nil, args)
+
+	return sql, args
+}
+
+// renderArgs generates the SQL of StringArgs with the dialect d, the default dialect when nil.
+func (w *Where) renderArgs(d Dialect, args []any) (string, []any, error) {
 	var conditions []string // Slice to hold individual condition strings.
 
 	// Process each condition in the Where struct.
 	if len(w.Conditions) > 0 {
 		for _, cond := range w.Conditions {
 			var _condition string
-			_condition, args = cond.StringArgs(args)
+			var err error
+
+			_condition, args, err = cond.renderArgs(d, args)
+			if err != nil {
+				return "", args, err
+			}
 
 			// Handle "OR" conditions.
 			if cond.AndOr == Or && len(conditions) > 0 {
@@ -231,11 +484,11 @@ func (w *Where) StringArgs(args []any) (string, []any) {
 
 	// Return an empty string if no conditions exist.
 	if len(conditions) == 0 {
-		return "", args
+		return "", args, nil
 	}
 
 	// Construct the WHERE clause by joining conditions with "AND".
-	return fmt.Sprintf("WHERE %s", strings.Join(conditions, " AND ")), args
+	return fmt.Sprintf("WHERE %s", strings.Join(conditions, " AND ")), args, nil
 }
 
 // StringArgs generates the SQL condition string and associated arguments.
@@ -247,13 +500,26 @@ func (w *Where) StringArgs(args []any) (string, []any) {
 // - string: The SQL condition as a string.
 // - []any: A slice containing the arguments used in the condition.
 func (c *Condition) StringArgs(args []any) (string, []any) {
+	sql, args, _ := c.renderArgs(nil, args)
+
+	return sql, args
+}
+
+// renderArgs generates the SQL of StringArgs with the dialect d, the default dialect when nil.
+func (c *Condition) renderArgs(d Dialect, args []any) (string, []any, error) {
+	var err error
+
 	// Handle group conditions (nested conditions).
 	if len(c.Group) > 0 {
 		var conditions []string // Slice to store grouped condition strings.
 
 		for _, cond := range c.Group {
 			var _condition string
-			_condition, args = cond.StringArgs(args)
+
+			_condition, args, err = cond.renderArgs(d, args)
+			if err != nil {
+				return "", args, err
+			}
 
 			// Handle "OR" conditions within the group.
 			if cond.AndOr == Or && len(conditions) > 0 {
@@ -268,46 +534,121 @@ func (c *Condition) StringArgs(args []any) (string, []any) {
 
 		// Return an empty string if no conditions are in the group.
 		if len(conditions) == 0 {
-			return "", args
+			return "", args, nil
 		}
 
-		return fmt.Sprintf("(%s)", strings.Join(conditions, " AND ")), args
+		return fmt.Sprintf("(%s)", strings.Join(conditions, " AND ")), args, nil
+	}
+
+	// An empty IN list is written without the field, so the parameters of the field are not bound.
+	if c.Opt == In || c.Opt == NotIn {
+		if values, ok := inValues(c.Value); ok && len(values) == 0 {
+			return c.emptyIn(), args, nil
+		}
+	}
+
+	// Render the field first, its parameters come before the parameters of the value.
+	var field any
+	field, args, err = dialectFieldArgs(d, c.Field, args)
+	if err != nil {
+		return "", args, err
+	}
+
+	// Handle a raw fragment written as the whole condition.
+	if c.isRaw() {
+		return fmt.Sprint(field), args, nil
 	}
 
 	// Handle ValueField type, excluding it from arguments.
 	if valueField, ok := c.Value.(IValueField); ok {
-		return fmt.Sprintf("%s %s %s", c.Field, c.opt(), valueField.Value()), args
+		return fmt.Sprintf("%s %s %s", field, c.opt(), valueField.Value()), args, nil
+	}
+
+	// Handle subqueries and nested QueryBuilder objects.
+	// WHERE salary = (SELECT DISTINCT salary FROM employees ORDER BY salary DESC LIMIT 1 , 1);
+	// WHERE CustomerID IN (SELECT CustomerID FROM Orders);
+	// WHERE CustomerID NOT IN (SELECT CustomerID FROM Orders);
+	// WHERE EXISTS (SELECT ProductName FROM Products);
+	// WHERE NOT EXISTS (SELECT ProductName FROM Products);
+	// WHERE ProductID = ANY (SELECT ProductID FROM OrderDetails WHERE Quantity = 10);
+	// WHERE ProductID > ALL (SELECT ProductID FROM OrderDetails WHERE Quantity = 10);
+	if valueQueryBuilder, ok := c.Value.(*QueryBuilder); ok {
+		var queryBuilderStr string
+
+		queryBuilderStr, args, err = valueQueryBuilder.renderArgs(d, args)
+		if err != nil {
+			return "", args, err
+		}
+
+		return fmt.Sprintf("%s %s (%v)", field, c.opt(), queryBuilderStr), args, nil
+	}
+
+	// Handle compound queries (UNION, INTERSECT, EXCEPT).
+	// WHERE id IN (SELECT id FROM customers UNION SELECT id FROM suppliers);
+	if valueCompound, ok := c.Value.(*CompoundBuilder); ok {
+		var compoundStr string
+
+		compoundStr, args, err = valueCompound.renderArgs(d, args)
+		if err != nil {
+			return "", args, err
+		}
+
+		return fmt.Sprintf("%s %s (%v)", field, c.opt(), compoundStr), args, nil
+	}
+
+	// Handle a named parameter of an IN or NOT IN list, bound as one array on PostgreSQL.
+	// WHERE id = ANY($1)
+	if param, ok := c.Value.(Param); ok && (c.Opt == In || c.Opt == NotIn) {
+		if !isDialect(d, PostgreSQL) {
+			return "", args, fmt.Errorf("%w: %s IN list bound to the parameter %s", ErrNotSupported, dialectOr(d).Name(), param)
+		}
+
+		var paramStr string
+
+		paramStr, args, err = param.renderArgs(d, args)
+		if err != nil {
+			return "", args, err
+		}
+
+		return c.anyArray(field, paramStr), args, nil
+	}
+
+	// Handle expression values, binding their parameters.
+	if valueExpr, ok := c.Value.(Expr); ok {
+		var exprStr string
+
+		exprStr, args, err = valueExpr.renderArgs(d, args)
+		if err != nil {
+			return "", args, err
+		}
+
+		return fmt.Sprintf("%s %s %s", field, c.opt(), exprStr), args, nil
 	}
 
 	// Handle IS NULL and IS NOT NULL conditions.
 	if c.Opt == Null || c.Opt == NotNull {
-		return fmt.Sprintf("%s %s", c.Field, c.opt()), args
+		return fmt.Sprintf("%s %s", field, c.opt()), args, nil
 	}
 
 	// Handle IN and NOT IN conditions.
 	if c.Opt == In || c.Opt == NotIn {
-		typ := reflect.TypeOf(c.Value)
+		if values, ok := inValues(c.Value); ok {
+			// Bind the whole list as one array parameter.
+			if arrayIn(d) {
+				args = append(args, c.Value)
 
-		if typ.Kind() == reflect.Slice || typ.Kind() == reflect.Array {
-			var valuesStr []string // Slice to store stringified values.
-
-			// Process string slices.
-			if values, ok := c.Value.([]string); ok {
-				for _, val := range values {
-					args = append(args, val)
-					valuesStr = append(valuesStr, p(args))
-				}
+				return c.anyArray(field, p(d, args)), args, nil
 			}
 
-			// Process integer slices.
-			if values, ok := c.Value.([]int); ok {
-				for _, val := range values {
-					args = append(args, val)
-					valuesStr = append(valuesStr, p(args))
-				}
+			var valuesStr []string // Slice to store stringified values.
+
+			// Process each value of the slice or array.
+			for _, val := range values {
+				args = append(args, val)
+				valuesStr = append(valuesStr, p(d, args))
 			}
 
-			return fmt.Sprintf("%s %s (%s)", c.Field, c.opt(), strings.Join(valuesStr, ", ")), args
+			return fmt.Sprintf("%s %s (%s)", field, c.opt(), strings.Join(valuesStr, ", ")), args, nil
 		}
 	}
 
@@ -319,37 +660,22 @@ func (c *Condition) StringArgs(args []any) (string, []any) {
 	// WHERE Price BETWEEN 10 AND 20
 	if c.Opt == Between || c.Opt == NotBetween {
 		var betweenValue string
-		betweenValue, args = c.Value.(ValueBetween).StringArgs(args)
+		betweenValue, args = c.Value.(ValueBetween).renderArgs(d, args)
 
-		return fmt.Sprintf("%s %s %v", c.Field, c.opt(), betweenValue), args
-	}
-
-	// Handle subqueries and nested QueryBuilder objects.
-	// WHERE salary = (SELECT DISTINCT salary FROM employees ORDER BY salary DESC LIMIT 1 , 1);
-	// WHERE CustomerID IN (SELECT CustomerID FROM Orders);
-	// WHERE CustomerID NOT IN (SELECT CustomerID FROM Orders);
-	// WHERE EXISTS (SELECT ProductName FROM Products);
-	// WHERE NOT EXISTS (SELECT ProductName FROM Products);
-	// WHERE ProductID = ANY (SELECT ProductID FROM OrderDetails WHERE Quantity = 10);
-	// WHERE ProductID > ALL (SELECT ProductID FROM OrderDetails WHERE Quantity = 10);
-	if valueQueryBuilder, ok := c.Value.(*QueryBuilder); ok {
-		var queryBuilderStr string
-		queryBuilderStr, args, _ = valueQueryBuilder.StringArgs(args)
-
-		return fmt.Sprintf("%s %s (%v)", c.Field, c.opt(), queryBuilderStr), args
+		return fmt.Sprintf("%s %s %v", field, c.opt(), betweenValue), args, nil
 	}
 
 	// Handle string values directly.
 	if valueString, ok := c.Value.(string); ok {
 		args = append(args, valueString)
 
-		return fmt.Sprintf("%s %s %s", c.Field, c.opt(), p(args)), args
+		return fmt.Sprintf("%s %s %s", field, c.opt(), p(d, args)), args, nil
 	}
 
 	// Handle all other value types.
 	args = append(args, c.Value)
 
-	return fmt.Sprintf("%s %s %s", c.Field, c.opt(), p(args)), args
+	return fmt.Sprintf("%s %s %s", field, c.opt(), p(d, args)), args, nil
 }
 
 // StringArgs generates the SQL representation for a ValueBetween range
@@ -362,13 +688,18 @@ func (c *Condition) StringArgs(args []any) (string, []any) {
 // - string: The SQL representation of the range in the format "LOW_PLACEHOLDER AND HIGH_PLACEHOLDER".
 // - []any: The updated slice of arguments, including Low and High values.
 func (v ValueBetween) StringArgs(args []any) (string, []any) {
+	return v.renderArgs(nil, args)
+}
+
+// renderArgs generates the SQL of StringArgs with the dialect d, the default dialect when nil.
+func (v ValueBetween) renderArgs(d Dialect, args []any) (string, []any) {
 	// Append lower bound to arguments and get its placeholder.
 	args = append(args, v.Low)
-	pLow := p(args)
+	pLow := p(d, args)
 
 	// Append upper bound to arguments and get its placeholder.
 	args = append(args, v.High)
-	pHigh := p(args)
+	pHigh := p(d, args)
 
 	// Return SQL representation and updated arguments.
 	// hire_date BETWEEN '1999-01-01' AND '2000-12-31'
@@ -386,28 +717,34 @@ func (v ValueBetween) StringArgs(args []any) (string, []any) {
 // - string: The SQL representation for the year extraction, customized for the database type.
 // - []any: The updated slice of arguments, including the field value.
 func (v FieldYear) StringArgs(args []any) (string, []any) {
+	return v.renderArgs(nil, args)
+}
+
+// renderArgs generates the SQL of StringArgs with the dialect d, the default dialect when nil.
+func (v FieldYear) renderArgs(d Dialect, args []any) (string, []any) {
 	// Append the field value to arguments and get its placeholder.
 	args = append(args, string(v))
 
-	return DefaultDialect().YearFunction(p(args)), args
+	return dialectOr(d).YearFunction(p(d, args)), args
 }
 
-// StringArgs generates the SQL GROUP BY clause string.
+// StringArgs generates the SQL GROUP BY clause string and appends the parameters of its expressions.
 //
 // Parameters:
-// - args []any: The input slice of arguments (unused in this case).
+// - args []any: The input slice of arguments.
 //
 // Returns:
 // - string: The SQL GROUP BY clause string. Returns an empty string if no items are present.
-// - []any: The unchanged slice of arguments.
+// - []any: The updated slice of arguments.
 func (g *GroupBy) StringArgs(args []any) (string, []any) {
-	// Return empty if there are no group by items.
-	if len(g.Items) == 0 {
-		return "", args
-	}
+	sql, args, _ := g.renderArgs(nil, args)
+
+	return sql, args
+}
 
-	// Construct and return GROUP BY clause.
-	return fmt.Sprintf("GROUP BY %s", strings.Join(g.Items, ", ")), args
+// renderArgs generates the SQL of StringArgs with the dialect d, the default dialect when nil.
+func (g *GroupBy) renderArgs(d Dialect, args []any) (string, []any, error) {
+	return g.build(d, args, true)
 }
 
 // StringArgs generates the SQL HAVING clause string and appends the associated argument values.
@@ -419,6 +756,13 @@ func (g *GroupBy) StringArgs(args []any) (string, []any) {
 // - string: The SQL HAVING clause string, combining conditions with "AND". Returns an empty string if no conditions are present.
 // - []any: The updated slice of arguments, including condition values.
 func (w *Having) StringArgs(args []any) (string, []any) {
+	sql, args, _ := w.renderArgs(nil, args)
+
+	return sql, args
+}
+
+// renderArgs generates the SQL of StringArgs with the dialect d, the default dialect when nil.
+func (w *Having) renderArgs(d Dialect, args []any) (string, []any, error) {
 	var conditions []string
 
 	// Process each HAVING condition.
@@ -426,7 +770,12 @@ func (w *Having) StringArgs(args []any) (string, []any) {
 		for _, cond := range w.Conditions {
 			// Generate SQL and update arguments for each condition.
 			var _condition string
-			_condition, args = cond.StringArgs(args)
+			var err error
+
+			_condition, args, err = cond.renderArgs(d, args)
+			if err != nil {
+				return "", args, err
+			}
 
 			// Handle "OR" conditions.
 			if cond.AndOr == Or && len(conditions) > 0 {
@@ -442,35 +791,30 @@ func (w *Having) StringArgs(args []any) (string, []any) {
 
 	// Return empty string if no conditions exist.
 	if len(conditions) == 0 {
-		return "", args
+		return "", args, nil
 	}
 
 	// Construct HAVING clause by joining conditions with "AND".
-	return fmt.Sprintf("HAVING %s", strings.Join(conditions, " AND ")), args
+	return fmt.Sprintf("HAVING %s", strings.Join(conditions, " AND ")), args, nil
 }
 
-// StringArgs generates the SQL ORDER BY clause string.
+// StringArgs generates the SQL ORDER BY clause string and appends the parameters of its expressions.
 //
 // Parameters:
-// - args []any: The input slice of arguments (unused in this case).
+// - args []any: The input slice of arguments.
 //
 // Returns:
 // - string: The SQL ORDER BY clause string. Returns an empty string if no items are present.
-// - []any: The unchanged slice of arguments.
+// - []any: The updated slice of arguments.
 func (o *OrderBy) StringArgs(args []any) (string, []any) {
-	// Return empty if there are no order by items.
-	if len(o.Items) == 0 {
-		return "", args
-	}
+	sql, args, _ := o.renderArgs(nil, args)
 
-	var orderItems []string
-	// Process each ORDER BY item.
-	for _, item := range o.Items {
-		orderItems = append(orderItems, fmt.Sprintf("%s %s", item.Field, item.Dir()))
-	}
+	return sql, args
+}
 
-	// Construct and return ORDER BY clause.
-	return fmt.Sprintf("ORDER BY %s", strings.Join(orderItems, ", ")), args
+// renderArgs generates the SQL of StringArgs with the dialect d, the default dialect when nil.
+func (o *OrderBy) renderArgs(d Dialect, args []any) (string, []any, error) {
+	return o.build(d, args, true)
 }
 
 // StringArgs generates the SQL LIMIT and OFFSET clause strings
@@ -483,15 +827,23 @@ func (o *OrderBy) StringArgs(args []any) (string, []any) {
 // - string: The SQL LIMIT and OFFSET clause string. Returns an empty string if both values are zero.
 // - []any: The updated slice of arguments, including limit and offset values.
 func (l *Limit) StringArgs(args []any) (string, []any) {
-	// Append limit and offset values, and generate placeholders.
-	if l.Limit > 0 || l.Offset > 0 {
-		args = append(args, l.Limit)
-		pLimit := p(args)
-		args = append(args, l.Offset)
-		pOffset := p(args)
+	return l.renderArgs(nil, args)
+}
 
-		// Construct and return LIMIT/OFFSET clause.
-		return fmt.Sprintf("LIMIT %s OFFSET %s", pLimit, pOffset), args
+// renderArgs generates the SQL of StringArgs with the dialect d, the default dialect when nil.
+func (l *Limit) renderArgs(d Dialect, args []any) (string, []any) {
+	// Append limit and offset values in the order of the dialect, and generate placeholders.
+	if l.isSet() {
+		limit, offset := l.values()
+
+		sql := dialectOr(d).Limit(limit, offset, func(value any) string {
+			args = append(args, value)
+
+			return p(d, args)
+		})
+
+		// Return the paging clause of the dialect.
+		return sql, args
 	}
 
 	// Return empty string if no limit or offset is set.
@@ -508,12 +860,19 @@ func (l *Limit) StringArgs(args []any) (string, []any) {
 // - string: The SQL FETCH NEXT ROWS clause string. Returns an empty string if both values are zero.
 // - []any: The updated slice of arguments, including fetch and offset values.
 func (f *Fetch) StringArgs(args []any) (string, []any) {
+	return f.renderArgs(nil, args)
+}
+
+// renderArgs generates the SQL of StringArgs with the dialect d, the default dialect when nil.
+func (f *Fetch) renderArgs(d Dialect, args []any) (string, []any) {
 	// Append fetch and offset values, and generate placeholders.
-	if f.Fetch > 0 || f.Offset > 0 {
-		args = append(args, f.Offset)
-		pOffset := p(args)
-		args = append(args, f.Fetch)
-		pFetch := p(args)
+	if f.isSet() {
+		fetch, offset := f.values()
+
+		args = append(args, offset)
+		pOffset := p(d, args)
+		args = append(args, fetch)
+		pFetch := p(d, args)
 
 		// Construct and return FETCH NEXT ROWS clause.
 		return fmt.Sprintf("OFFSET %s ROWS FETCH NEXT %s ROWS ONLY", pOffset, pFetch), args
@@ -533,50 +892,402 @@ func (f *Fetch) StringArgs(args []any) (string, []any) {
 // - string: The SQL WHEN clause string.
 // - []any: The updated slice of arguments, including value and condition values.
 func (c *WhenCase) StringArgs(args []any) (string, []any) {
+	sql, args, _ := c.renderArgs(nil, args)
+
+	return sql, args
+}
+
+// renderArgs generates the SQL of StringArgs with the dialect d, the default dialect when nil.
+func (c *WhenCase) renderArgs(d Dialect, args []any) (string, []any, error) {
+	var whenStr, thenStr string
+	var err error
+
 	// Process conditions and construct the WHEN clause SQL.
-	if valueConditions, ok := c.Conditions.([]Condition); ok {
+	if valueConditions, ok := c.conditions(); ok {
 		var cons []string
 		for _, condition := range valueConditions {
 			var sqlPart string
-			sqlPart, args = condition.StringArgs(args)
+
+			sqlPart, args, err = condition.renderArgs(d, args)
+			if err != nil {
+				return "", args, err
+			}
 
 			cons = append(cons, sqlPart)
 		}
 
-		// Append the value associated with the WHEN clause.
-		args = append(args, c.Value)
-
-		// Construct and return the WHEN clause.
-		return fmt.Sprintf("WHEN %s THEN '?'", strings.Join(cons, " AND ")), args
+		whenStr = joinConditions(valueConditions, cons)
+	} else if valueExpr, ok := c.Conditions.(Expr); ok {
+		// Bind the parameters of an expression compared with the expression of a simple CASE.
+		whenStr, args, err = operandArgs(d, valueExpr, args)
+		if err != nil {
+			return "", args, err
+		}
+	} else {
+		// Write the value compared with the expression of a simple CASE as a literal.
+		whenStr = operand(d, c.Conditions)
 	}
 
-	// Append the value associated with the WHEN clause.
-	args = append(args, c.Value)
+	// Bind the value associated with the WHEN clause.
+	thenStr, args, err = caseValueArgs(d, c.Value, args)
+	if err != nil {
+		return "", args, err
+	}
 
-	// Return the WHEN clause for a single condition.
-	return fmt.Sprintf("WHEN %v THEN '?'", c.Conditions), args
+	return fmt.Sprintf("WHEN %s THEN %s", whenStr, thenStr), args, nil
 }
 
 // StringArgs generates the SQL CASE statement string
 // and appends the associated arguments to the slice.
 //
 // Parameters:
-// - args []any: The input slice to which the expression and WHEN clause arguments will be appended.
+// - args []any: The input slice to which the expression, WHEN and ELSE clause arguments will be appended.
 //
 // Returns:
 // - string: The SQL CASE statement string.
-// - []any: The updated slice of arguments, including expression and WHEN clause values.
+// - []any: The updated slice of arguments.
 func (c *Case) StringArgs(args []any) (string, []any) {
-	var whenCases []string
+	sql, args, _ := c.renderArgs(nil, args)
+
+	return sql, args
+}
+
+// renderArgs generates the SQL of StringArgs with the dialect d, the default dialect when nil.
+func (c *Case) renderArgs(d Dialect, args []any) (string, []any, error) {
+	var sqlPart string
+	var err error
+
+	parts := []string{"CASE"}
+
+	// Process the expression of a simple CASE.
+	if c.Exp != nil && c.Exp != "" {
+		sqlPart, args, err = operandArgs(d, c.Exp, args)
+		if err != nil {
+			return "", args, err
+		}
+
+		parts = append(parts, sqlPart)
+	}
 
 	// Process each WHEN clause in the CASE statement.
 	for _, whenClause := range c.WhenClauses {
+		sqlPart, args, err = whenClause.renderArgs(d, args)
+		if err != nil {
+			return "", args, err
+		}
+
+		parts = append(parts, sqlPart)
+	}
+
+	// Process the ELSE clause.
+	if c.ElseValue != nil {
+		sqlPart, args, err = caseValueArgs(d, c.ElseValue, args)
+		if err != nil {
+			return "", args, err
+		}
+
+		parts = append(parts, "ELSE "+sqlPart)
+	}
+
+	parts = append(parts, "END")
+
+	if c.Name != "" {
+		parts = append(parts, c.Name)
+	}
+
+	return strings.Join(parts, " "), args, nil
+}
+
+// StringArgs generates the SQL representation of a single CTE
+// and appends the arguments of its body to the slice.
+//
+// Parameters:
+// - args []any: The input slice to which the CTE body arguments will be appended.
+//
+// Returns:
+// - string: The CTE in the format `name [(columns)] AS [[NOT] MATERIALIZED] (query)`.
+// - []any: The updated slice of arguments.
+func (c *CTE) StringArgs(args []any) (string, []any) {
+	sql, args, _ := c.renderArgs(nil, args)
+
+	return sql, args
+}
+
+// renderArgs generates the SQL of StringArgs with the dialect d, the default dialect when nil.
+func (c *CTE) renderArgs(d Dialect, args []any) (string, []any, error) {
+	var body string
+	var err error
+
+	switch query := c.Query.(type) {
+	case *QueryBuilder:
+		body, args, err = query.renderArgs(d, args)
+	case *CompoundBuilder:
+		body, args, err = query.renderArgs(d, args)
+	default:
+		err = fmt.Errorf("fluentsql: CTE %s must be a *QueryBuilder or a *CompoundBuilder, got %T", c.Name, c.Query)
+	}
+
+	if err != nil {
+		return "", args, err
+	}
+
+	return fmt.Sprintf("%s(%s)", c.head(d), body), args, nil
+}
+
+// StringArgs generates the SQL WITH clause string and appends the arguments
+// of every CTE body to the slice, so placeholders keep numbering into the main statement.
+//
+// Parameters:
+// - args []any: The input slice to which the CTE arguments will be appended.
+//
+// Returns:
+// - string: The SQL WITH clause string. Returns an empty string if no CTEs are defined.
+// - []any: The updated slice of arguments.
+func (w *With) StringArgs(args []any) (string, []any) {
+	sql, args, _ := w.renderArgs(nil, args)
+
+	return sql, args
+}
+
+// renderArgs generates the SQL of StringArgs with the dialect d, the default dialect when nil.
+func (w *With) renderArgs(d Dialect, args []any) (string, []any, error) {
+	if len(w.Items) == 0 {
+		return "", args, nil
+	}
+
+	var items []string
+	for _, item := range w.Items {
 		var sqlPart string
-		sqlPart, args = whenClause.StringArgs(args)
+		var err error
+
+		sqlPart, args, err = item.renderArgs(d, args)
+		if err != nil {
+			return "", args, err
+		}
+
+		items = append(items, sqlPart)
+	}
+
+	return fmt.Sprintf("%s %s", w.keyword(d), strings.Join(items, ", ")), args, nil
+}
+
+// StringArgs generates the SQL representation of the window specification
+// without the enclosing parentheses.
+//
+// Parameters:
+// - args []any: The input slice of arguments.
+//
+// Returns:
+// - string: The window specification string.
+// - []any: The updated slice of arguments.
+func (w *WindowSpec) StringArgs(args []any) (string, []any) {
+	sql, args, _ := w.renderArgs(nil, args)
+
+	return sql, args
+}
+
+// renderArgs generates the SQL of StringArgs with the dialect d, the default dialect when nil.
+func (w *WindowSpec) renderArgs(d Dialect, args []any) (string, []any, error) {
+	var parts []string
+
+	if w.Base != "" {
+		parts = append(parts, w.Base)
+	}
+
+	if len(w.Partition) > 0 {
+		parts = append(parts, fmt.Sprintf("PARTITION BY %s", strings.Join(w.Partition, ", ")))
+	}
 
-		whenCases = append(whenCases, sqlPart)
+	orderBySql, args, err := w.Order.renderArgs(d, args)
+	if err != nil {
+		return "", args, err
 	}
+	if orderBySql != "" {
+		parts = append(parts, orderBySql)
+	}
+
+	frameSql := w.Frame.String()
+	if frameSql != "" {
+		parts = append(parts, frameSql)
+	}
+
+	return strings.Join(parts, " "), args, nil
+}
+
+// StringArgs generates the SQL representation of the window function column
+// and appends its additional arguments (e.g. the LAG offset and default value) to the slice.
+//
+// Parameters:
+// - args []any: The input slice to which the function arguments will be appended.
+//
+// Returns:
+// - string: The window function column with placeholders.
+// - []any: The updated slice of arguments.
+func (f *WindowFunction) StringArgs(args []any) (string, []any) {
+	sql, args, _ := f.renderArgs(nil, args)
+
+	return sql, args
+}
+
+// renderArgs generates the SQL of StringArgs with the dialect d, the default dialect when nil.
+func (f *WindowFunction) renderArgs(d Dialect, args []any) (string, []any, error) {
+	var arguments []string
+
+	if f.Field != "" {
+		arguments = append(arguments, f.Field)
+	}
+
+	// Bind every additional argument as a parameter.
+	for _, arg := range f.Args {
+		args = append(args, arg)
+		arguments = append(arguments, p(d, args))
+	}
+
+	over := "OVER ()"
+	if f.Window != nil && f.Window.isReference() {
+		over = fmt.Sprintf("OVER %s", f.Window.Base)
+	} else if f.Window != nil {
+		var spec string
+		var err error
+
+		spec, args, err = f.Window.renderArgs(d, args)
+		if err != nil {
+			return "", args, err
+		}
+
+		over = fmt.Sprintf("OVER (%s)", spec)
+	}
+
+	return fmt.Sprintf("%s(%s) %s%s", f.Function, strings.Join(arguments, ", "), over, f.alias()), args, nil
+}
+
+// StringArgs generates the SQL WINDOW clause string.
+//
+// Parameters:
+// - args []any: The input slice of arguments.
+//
+// Returns:
+// - string: The SQL WINDOW clause string. Returns an empty string if no windows are declared.
+// - []any: The updated slice of arguments.
+func (w *Window) StringArgs(args []any) (string, []any) {
+	sql, args, _ := w.renderArgs(nil, args)
+
+	return sql, args
+}
+
+// renderArgs generates the SQL of StringArgs with the dialect d, the default dialect when nil.
+func (w *Window) renderArgs(d Dialect, args []any) (string, []any, error) {
+	if len(w.Items) == 0 {
+		return "", args, nil
+	}
+
+	var items []string
+	for _, item := range w.Items {
+		var spec string
+		var err error
+
+		spec, args, err = item.Spec.renderArgs(d, args)
+		if err != nil {
+			return "", args, err
+		}
+
+		items = append(items, fmt.Sprintf("%s AS (%s)", item.Name, spec))
+	}
+
+	return fmt.Sprintf("WINDOW %s", strings.Join(items, ", ")), args, nil
+}
+
+// StringArgs generates the SQL locking clause for the current dialect.
+//
+// Parameters:
+// - args []any: The input slice of arguments (unused in this case).
+//
+// Returns:
+// - string: The SQL locking clause. Returns an empty string if no lock is set.
+// - []any: The unchanged slice of arguments.
+// - error: ErrNotSupported when the dialect cannot express the lock.
+func (l *Lock) StringArgs(args []any) (string, []any, error) {
+	return l.renderArgs(nil, args)
+}
+
+// renderArgs generates the SQL of StringArgs with the dialect d, the default dialect when nil.
+func (l *Lock) renderArgs(d Dialect, args []any) (string, []any, error) {
+	sql, err := l.lock(d)
+
+	return sql, args, err
+}
+
+// StringArgs generates the RETURNING clause for the current dialect.
+//
+// Parameters:
+// - args []any: The input slice of arguments (unused in this case).
+//
+// Returns:
+// - string: The RETURNING clause. Returns an empty string if no column is set.
+// - []any: The unchanged slice of arguments.
+// - error: ErrNotSupported when the dialect has no RETURNING clause.
+func (r *Returning) StringArgs(args []any) (string, []any, error) {
+	return r.renderArgs(nil, args)
+}
+
+// renderArgs generates the SQL of StringArgs with the dialect d, the default dialect when nil.
+func (r *Returning) renderArgs(d Dialect, args []any) (string, []any, error) {
+	sql, err := r.returning(d)
+
+	return sql, args, err
+}
+
+// StringArgs generates the LIMIT BY clause for the current dialect
+// and appends the limit and offset values to the arguments slice.
+//
+// Parameters:
+// - args []any: The input slice to which the limit and offset values will be appended.
+//
+// Returns:
+// - string: The LIMIT BY clause. Returns an empty string if no limit or expression is set.
+// - []any: The updated slice of arguments, including limit and offset values.
+// - error: ErrNotSupported when the dialect is not ClickHouse.
+func (l *LimitBy) StringArgs(args []any) (string, []any, error) {
+	return l.renderArgs(nil, args)
+}
+
+// renderArgs generates the SQL of StringArgs with the dialect d, the default dialect when nil.
+func (l *LimitBy) renderArgs(d Dialect, args []any) (string, []any, error) {
+	if l.Limit <= 0 || len(l.By) == 0 {
+		return "", args, nil
+	}
+
+	args = append(args, l.Limit)
+	pLimit := p(d, args)
+
+	var pOffset string
+	if l.Offset > 0 {
+		args = append(args, l.Offset)
+		pOffset = p(d, args)
+	}
+
+	sql, err := l.limitBy(d, pLimit, pOffset)
+
+	return sql, args, err
+}
+
+// StringArgs generates the SETTINGS clause for the current dialect.
+// Settings only accept literals, so their values are not appended to the arguments slice.
+//
+// Parameters:
+// - args []any: The input slice of arguments (unused in this case).
+//
+// Returns:
+// - string: The SETTINGS clause. Returns an empty string if no setting is set.
+// - []any: The unchanged slice of arguments.
+// - error: ErrNotSupported when the dialect is not ClickHouse.
+func (s *Settings) StringArgs(args []any) (string, []any, error) {
+	return s.renderArgs(nil, args)
+}
+
+// renderArgs generates the SQL of StringArgs with the dialect d, the default dialect when nil.
+func (s *Settings) renderArgs(d Dialect, args []any) (string, []any, error) {
+	sql, err := s.settings(d)
 
-	// Construct and return the CASE statement.
-	return fmt.Sprintf("CASE %s %s END %s", c.Exp, strings.Join(whenCases, " "), c.Name), args
+	return sql, args, err
 }
@@ -1,6 +1,7 @@
 package fluentsql
 
 import (
+	"errors"
 	"strings"
 	"testing"
 )
@@ -86,7 +87,7 @@ func TestWhenCaseStringArgs(t *testing.T) {
 	var args []any
 	sql, args := whenCase.StringArgs(args)
 
-	expectedSQL := "WHEN 1 THEN '?'"
+	expectedSQL := "WHEN 1 THEN $1"
 	if sql != expectedSQL {
 		t.Fatalf("Expected SQL %s, got %s", expectedSQL, sql)
 	}
@@ -121,7 +122,7 @@ func TestWhenCaseStringArgs(t *testing.T) {
 	args = []any{}
 	sql, args = whenCase.StringArgs(args)
 
-	expectedSQL = "WHEN salary > $1 AND salary < $2 THEN '?'"
+	expectedSQL = "WHEN salary > $1 AND salary < $2 THEN $3"
 	if sql != expectedSQL {
 		t.Fatalf("Expected SQL %s, got %s", expectedSQL, sql)
 	}
@@ -155,7 +156,7 @@ func TestCaseStringArgs(t *testing.T) {
 	var args []any
 	sql, args := caseTest.StringArgs(args)
 
-	expectedSQL := "CASE (2000 - YEAR(hire_date)) WHEN 1 THEN '?' WHEN 3 THEN '?' END anniversary"
+	expectedSQL := "CASE (2000 - YEAR(hire_date)) WHEN 1 THEN $1 WHEN 3 THEN $2 END anniversary"
 	if sql != expectedSQL {
 		t.Fatalf("Expected SQL %s, got %s", expectedSQL, sql)
 	}
@@ -197,7 +198,7 @@ func TestCaseStringArgs(t *testing.T) {
 	args = []any{}
 	sql, args = caseTest.StringArgs(args)
 
-	expectedSQL = "CASE  WHEN salary < $1 THEN '?' WHEN salary > $3 THEN '?' END evaluation"
+	expectedSQL = "CASE WHEN salary < $1 THEN $2 WHEN salary > $3 THEN $4 END evaluation"
 	if sql != expectedSQL {
 		t.Fatalf("Expected SQL %s, got %s", expectedSQL, sql)
 	}
@@ -284,7 +285,7 @@ func TestSelectStringArgs(t *testing.T) {
 	var args []any
 	sql, args := selectObj.StringArgs(args)
 
-	if !strings.Contains(sql, "CASE department_id WHEN 1 THEN '?' WHEN 2 THEN '?' END department_name") {
+	if !strings.Contains(sql, "CASE department_id WHEN 1 THEN $1 WHEN 2 THEN $2 END department_name") {
 		t.Fatalf("Expected SQL to contain CASE statement, got %s", sql)
 	}
 
@@ -499,3 +500,32 @@ func TestFetchStringArgs(t *testing.T) {
 		t.Fatalf("Expected 0 arguments, got %d", len(args))
 	}
 }
+
+// TestSubqueryError tests that the error of a nested query is returned in every position
+func TestSubqueryError(t *testing.T) {
+	// CUBE is not supported by SQLite
+	cube := func() *QueryBuilder {
+		return QueryInstance().Select("region").From("sales").GroupBy(Cube("region", "product"))
+	}
+
+	sqlite := new(SQLiteDialect)
+
+	testCases := []struct {
+		name string
+		sql  func() (string, []any, error)
+	}{
+		{"SELECT column", QueryInstance().SetDialect(sqlite).Select("id", cube().AS("region")).From("users").Sql},
+		{"FROM", QueryInstance().SetDialect(sqlite).From(cube().AS("s")).Sql},
+		{"WHERE", QueryInstance().SetDialect(sqlite).From("users").Where("region", In, cube()).Sql},
+		{"JOIN", QueryInstance().SetDialect(sqlite).From("users u").JoinUsing(InnerJoin, cube().AS("s"), "region").Sql},
+		{"expression", QueryInstance().SetDialect(sqlite).From("users").Where(Coalesce(cube(), Arg("x")), Eq, "x").Sql},
+		{"INSERT SELECT", InsertInstance().SetDialect(sqlite).Insert("regions", "name").Query(cube()).Sql},
+		{"SET", UpdateInstance().SetDialect(sqlite).Update("users").Set("region", cube()).Where("id", Eq, 1).Sql},
+	}
+
+	for _, testCase := range testCases {
+		if _, _, err := testCase.sql(); !errors.Is(err, ErrNotSupported) {
+			t.Fatalf("%s: expected ErrNotSupported, got %v", testCase.name, err)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package fluentsql
 
 import (
+	"errors"
 	"testing"
 )
 
@@ -160,7 +161,7 @@ func TestQueryBetweenCase(t *testing.T) {
 					High: 1993,
 				}).
 			OrderBy("hire_date", Asc),
-		"SELECT first_name, last_name, CASE  WHEN salary < 3000 THEN 'Low' WHEN salary >= 3000 AND salary <= 5000 THEN 'Average' WHEN salary > 5000 THEN 'High' END evaluation FROM employees": QueryInstance().
+		"SELECT first_name, last_name, CASE WHEN salary < 3000 THEN 'Low' WHEN salary >= 3000 AND salary <= 5000 THEN 'Average' WHEN salary > 5000 THEN 'High' END evaluation FROM employees": QueryInstance().
 			Select("first_name", "last_name", fieldCase).
 			From("employees"),
 	}
@@ -741,3 +742,230 @@ func TestQueryWhereCondition(t *testing.T) {
 		}
 	}
 }
+
+// TestQueryWith
+func TestQueryWith(t *testing.T) {
+	testCases := map[string]*QueryBuilder{
+		"WITH regional_sales AS (SELECT region, SUM(amount) AS total_sales FROM orders GROUP BY region) SELECT region FROM regional_sales WHERE total_sales > 1000": QueryInstance().
+			With("regional_sales", QueryInstance().
+				Select("region", "SUM(amount) AS total_sales").
+				From("orders").
+				GroupBy("region"),
+			).
+			Select("region").
+			From("regional_sales").
+			Where("total_sales", Greater, 1000),
+		"WITH RECURSIVE subordinates (employee_id, manager_id) AS (SELECT employee_id, manager_id FROM employees WHERE manager_id IS NULL) SELECT * FROM subordinates": QueryInstance().
+			WithRecursive("subordinates", QueryInstance().
+				Select("employee_id", "manager_id").
+				From("employees").
+				Where("manager_id", Null, nil),
+				"employee_id", "manager_id",
+			).
+			Select().
+			From("subordinates"),
+		"WITH w AS MATERIALIZED (SELECT id FROM big_table) SELECT id FROM w": QueryInstance().
+			WithMaterialized("w", Materialized, QueryInstance().Select("id").From("big_table")).
+			Select("id").
+			From("w"),
+	}
+
+	for expected, query := range testCases {
+		if query.String() != expected {
+			t.Fatalf(`Query %s != %s`, query.String(), expected)
+		}
+	}
+}
+
+// TestQueryWithArgs
+func TestQueryWithArgs(t *testing.T) {
+	query := QueryInstance().
+		With("active", QueryInstance().
+			Select("id").
+			From("users").
+			Where("status", Eq, "active"),
+		).
+		WithCTE(CTE{
+			Name:         "recent",
+			Materialized: NotMaterialized,
+			Query: QueryInstance().
+				Select("user_id").
+				From("orders").
+				Where("total", Greater, 50),
+		}).
+		Select("id").
+		From("active").
+		Where("id", In, QueryInstance().Select("user_id").From("recent")).
+		Limit(10, 0)
+
+	sql, args, err := query.Sql()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected := "WITH active AS (SELECT id FROM users WHERE status = $1), recent AS NOT MATERIALIZED (SELECT user_id FROM orders WHERE total > $2) SELECT id FROM active WHERE id IN (SELECT user_id FROM recent) LIMIT $3 OFFSET $4"
+	if sql != expected {
+		t.Fatalf(`Query %s != %s`, sql, expected)
+	}
+
+	if len(args) != 4 || args[0] != "active" || args[1] != 50 || args[2] != 10 || args[3] != 0 {
+		t.Fatalf("Unexpected arguments %v", args)
+	}
+}
+
+// TestQueryWindow
+func TestQueryWindow(t *testing.T) {
+	query := QueryInstance().
+		Select("employee_id", "salary",
+			RowNumber().OverWindow("w").AS("rn"),
+			Lag("salary", 1, 0).OverWindow("w").AS("previous_salary"),
+		).
+		From("employees").
+		Where("department_id", Eq, 8).
+		Window("w", WindowInstance().PartitionBy("department_id").OrderBy("salary", Desc)).
+		OrderBy("employee_id", Asc)
+
+	expected := "SELECT employee_id, salary, ROW_NUMBER() OVER w AS rn, LAG(salary, 1, 0) OVER w AS previous_salary FROM employees WHERE department_id = 8 WINDOW w AS (PARTITION BY department_id ORDER BY salary DESC) ORDER BY employee_id ASC"
+	if query.String() != expected {
+		t.Fatalf(`Query %s != %s`, query.String(), expected)
+	}
+
+	sql, args, _ := query.Sql()
+
+	expected = "SELECT employee_id, salary, ROW_NUMBER() OVER w AS rn, LAG(salary, $1, $2) OVER w AS previous_salary FROM employees WHERE department_id = $3 WINDOW w AS (PARTITION BY department_id ORDER BY salary DESC) ORDER BY employee_id ASC"
+	if sql != expected {
+		t.Fatalf(`Query %s != %s`, sql, expected)
+	}
+
+	if len(args) != 3 || args[0] != 1 || args[1] != 0 || args[2] != 8 {
+		t.Fatalf("Unexpected arguments %v", args)
+	}
+}
+
+// TestQueryLock
+func TestQueryLock(t *testing.T) {
+	query := QueryInstance().
+		Select("id").
+		From("jobs").
+		Where("status", Eq, "pending").
+		OrderBy("id", Asc).
+		Limit(10, 0).
+		ForUpdate().
+		SkipLocked()
+
+	expected := "SELECT id FROM jobs WHERE status = 'pending' ORDER BY id ASC LIMIT 10 OFFSET 0 FOR UPDATE SKIP LOCKED"
+	if query.String() != expected {
+		t.Fatalf(`Query %s != %s`, query.String(), expected)
+	}
+
+	sql, args, err := query.Sql()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected = "SELECT id FROM jobs WHERE status = $1 ORDER BY id ASC LIMIT $2 OFFSET $3 FOR UPDATE SKIP LOCKED"
+	if sql != expected {
+		t.Fatalf(`Query %s != %s (%v)`, sql, expected, args)
+	}
+
+	query = QueryInstance().
+		Select("o.id").
+		From("orders", "o").
+		Join(InnerJoin, "customers c", Condition{Field: "c.id", Opt: Eq, Value: ValueField("o.customer_id")}).
+		ForShare("o").
+		NoWait()
+
+	expected = "SELECT o.id FROM orders o INNER JOIN customers c ON c.id = o.customer_id FOR SHARE OF o NOWAIT"
+	if query.String() != expected {
+		t.Fatalf(`Query %s != %s`, query.String(), expected)
+	}
+
+	query = QueryInstance().Select("id").From("accounts").Lock(LockForNoKeyUpdate)
+
+	expected = "SELECT id FROM accounts FOR NO KEY UPDATE"
+	if query.String() != expected {
+		t.Fatalf(`Query %s != %s`, query.String(), expected)
+	}
+}
+
+// TestQueryDistinct
+func TestQueryDistinct(t *testing.T) {
+	// Save the original dialect to restore it after the test
+	originalDialect := defaultDialect
+	defer func() {
+		defaultDialect = originalDialect
+	}()
+
+	query := QueryInstance().
+		DistinctOn("department_id").
+		Select("department_id", "first_name", "salary").
+		From("employees").
+		Where("salary", Greater, 1000).
+		OrderBy("department_id", Asc).
+		OrderBy("salary", Desc)
+
+	sql, args, err := query.Sql()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected := "SELECT DISTINCT ON (department_id) department_id, first_name, salary FROM employees WHERE salary > $1 ORDER BY department_id ASC, salary DESC"
+	if sql != expected {
+		t.Fatalf(`Query %s != %s (%v)`, sql, expected, args)
+	}
+
+	// DISTINCT ON is not available in MySQL
+	SetDialect(new(MySQLDialect))
+
+	if _, _, err = query.Sql(); !errors.Is(err, ErrNotSupported) {
+		t.Fatalf("Expected ErrNotSupported, got %v", err)
+	}
+
+	query = QueryInstance().
+		Distinct().
+		Modifier(SqlCalcFoundRows).
+		Select("salary").
+		From("employees").
+		Limit(10, 0)
+
+	sql, _, err = query.Sql()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected = "SELECT DISTINCT SQL_CALC_FOUND_ROWS salary FROM employees LIMIT ? OFFSET ?"
+	if sql != expected {
+		t.Fatalf(`Query %s != %s`, sql, expected)
+	}
+
+	// Select modifiers are MySQL-specific
+	SetDialect(new(PostgreSQLDialect))
+
+	if _, _, err = query.Sql(); !errors.Is(err, ErrNotSupported) {
+		t.Fatalf("Expected ErrNotSupported, got %v", err)
+	}
+
+	if query.Modifier(SqlNoCache).String() != "SELECT DISTINCT SQL_NO_CACHE SQL_CALC_FOUND_ROWS salary FROM employees LIMIT 10 OFFSET 0" {
+		t.Fatalf("Unexpected query %s", query.String())
+	}
+}
+
+// TestQuerySelectStruct tests the SELECT columns built from a tagged struct
+func TestQuerySelectStruct(t *testing.T) {
+	testCases := map[string]*QueryBuilder{
+		"SELECT id, name, email, age, created_at FROM users": SelectStruct[structUser](QueryInstance()).
+			From("users"),
+		"SELECT id, name FROM users WHERE id = 1": QueryInstance().
+			SelectStruct(&structUser{}, StructOptions{Include: []string{"id", "name"}}).
+			From("users").
+			Where("id", Eq, 1),
+		"SELECT name FROM countries": SelectStruct[*structCountry](QueryInstance(), StructOptions{SkipPrimaryKey: true}).
+			From("countries"),
+	}
+
+	for expected, query := range testCases {
+		if query.String() != expected {
+			t.Fatalf(`Query %s != %s`, query.String(), expected)
+		}
+	}
+}
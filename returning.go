@@ -0,0 +1,115 @@
+package fluentsql
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Returning clause represents the RETURNING part of an INSERT, UPDATE or DELETE statement.
+// It returns the values of the affected rows, e.g. the generated ID of an inserted row.
+//
+// Syntax:
+//
+//	RETURNING * | output_expression [[AS] output_name] [, ...]
+//
+// Notes:
+//   - Supported by PostgreSQL and SQLite (3.35+). MySQL and ClickHouse have no RETURNING clause, Oracle requires output binds.
+//   - SQL Server returns the rows with an OUTPUT clause, e.g. "OUTPUT inserted.id".
+type Returning struct {
+	Columns []string // Columns holds the returned columns or expressions, "*" returns every column.
+}
+
+// Append adds one or more columns or expressions to the RETURNING clause.
+//
+// Parameters:
+//   - columns ...string: The columns or expressions to be returned.
+func (r *Returning) Append(columns ...string) {
+	r.Columns = append(r.Columns, columns...)
+}
+
+// returning generates the RETURNING clause for the dialect.
+//
+// Parameters:
+//   - d: The dialect of the builder, the default dialect when nil.
+//
+// Returns:
+//   - string: The RETURNING clause. Returns an empty string if no column is set.
+//   - error: ErrNotSupported when the dialect has no RETURNING clause.
+func (r *Returning) returning(d Dialect) (string, error) {
+	if len(r.Columns) == 0 {
+		return "", nil
+	}
+
+	// Oracle only returns values INTO output binds, which statements do not carry.
+	if isDialect(d, MySQL) || isDialect(d, Oracle) || isDialect(d, ClickHouse) {
+		return "", fmt.Errorf("%w: %s RETURNING", ErrNotSupported, dialectOr(d).Name())
+	}
+
+	// SQL Server returns the rows with the OUTPUT clause generated by output.
+	if isDialect(d, SQLServer) {
+		return "", nil
+	}
+
+	return fmt.Sprintf("RETURNING %s", strings.Join(r.Columns, ", ")), nil
+}
+
+// String generates the RETURNING clause for the current dialect.
+// The clause is omitted when the dialect cannot express it; use StringArgs to get an error instead.
+//
+// Returns:
+//   - string: E.g. "RETURNING id, created_at". Returns an empty string if no column is set.
+func (r *Returning) String() string {
+	return r.render(nil)
+}
+
+// render generates the SQL of String with the dialect d, the default dialect when nil.
+func (r *Returning) render(d Dialect) string {
+	sql, _ := r.returning(d)
+
+	return sql
+}
+
+// output generates the OUTPUT clause SQL Server uses instead of RETURNING.
+// Plain columns and "*" are read from the given pseudo table, other expressions are kept as is.
+//
+// Parameters:
+//   - d: The dialect of the builder, the default dialect when nil.
+//   - table: The pseudo table holding the affected rows, "inserted" or "deleted".
+//
+// Returns:
+//   - string: E.g. "OUTPUT inserted.id, inserted.created_at". Returns an empty string
+//     if no column is set or the dialect is not SQL Server.
+func (r *Returning) output(d Dialect, table string) string {
+	if len(r.Columns) == 0 || !isDialect(d, SQLServer) {
+		return ""
+	}
+
+	columns := make([]string, 0, len(r.Columns))
+	for _, column := range r.Columns {
+		if column == "*" || isIdentifier(column) {
+			column = table + "." + column
+		}
+
+		columns = append(columns, column)
+	}
+
+	return fmt.Sprintf("OUTPUT %s", strings.Join(columns, ", "))
+}
+
+// isIdentifier reports whether s is a plain identifier made of letters, digits and underscores.
+func isIdentifier(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	for i, c := range s {
+		if c == '_' || unicode.IsLetter(c) || (i > 0 && unicode.IsDigit(c)) {
+			continue
+		}
+
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,63 @@
+package fluentsql
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestReturning
+func TestReturning(t *testing.T) {
+	testCases := map[string]Returning{
+		"":                               {},
+		"RETURNING *":                    {Columns: []string{"*"}},
+		"RETURNING id, created_at":       {Columns: []string{"id", "created_at"}},
+		"RETURNING price * 2 AS doubled": {Columns: []string{"price * 2 AS doubled"}},
+	}
+
+	for expected, returning := range testCases {
+		if returning.String() != expected {
+			t.Fatalf(`Query %s != %s`, returning.String(), expected)
+		}
+	}
+}
+
+// TestReturningDialect
+func TestReturningDialect(t *testing.T) {
+	originalDialect := defaultDialect
+	defer func() {
+		defaultDialect = originalDialect
+	}()
+
+	returning := Returning{Columns: []string{"id"}}
+
+	SetDialect(new(SQLiteDialect))
+
+	if sql, _, err := returning.StringArgs(nil); err != nil || sql != "RETURNING id" {
+		t.Fatalf("Expected RETURNING id, got %s (%v)", sql, err)
+	}
+
+	// MySQL has no RETURNING clause
+	SetDialect(new(MySQLDialect))
+
+	if returning.String() != "" {
+		t.Fatalf("Expected empty RETURNING clause, got %s", returning.String())
+	}
+
+	if _, _, err := returning.StringArgs(nil); !errors.Is(err, ErrNotSupported) {
+		t.Fatalf("Expected ErrNotSupported, got %v", err)
+	}
+
+	// SQL Server returns the rows with an OUTPUT clause
+	SetDialect(new(SQLServerDialect))
+
+	if returning.String() != "" {
+		t.Fatalf("Expected empty RETURNING clause, got %s", returning.String())
+	}
+
+	returning.Append("*", "price * 2 AS doubled")
+
+	expected := "OUTPUT deleted.id, deleted.*, price * 2 AS doubled"
+	if returning.output(nil, "deleted") != expected {
+		t.Fatalf("Query %s != %s", returning.output(nil, "deleted"), expected)
+	}
+}
@@ -2,13 +2,106 @@ package fluentsql
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 )
 
+// SelectModifier represents a MySQL-specific modifier placed after the SELECT keyword.
+// The constants follow the order required by the MySQL syntax.
+type SelectModifier int
+
+const (
+	HighPriority     SelectModifier = iota // HIGH_PRIORITY
+	StraightJoin                           // STRAIGHT_JOIN
+	SqlSmallResult                         // SQL_SMALL_RESULT
+	SqlBigResult                           // SQL_BIG_RESULT
+	SqlBufferResult                        // SQL_BUFFER_RESULT
+	SqlNoCache                             // SQL_NO_CACHE
+	SqlCalcFoundRows                       // SQL_CALC_FOUND_ROWS
+)
+
+// String returns the SQL keyword of the select modifier.
+//
+// Returns:
+// - string: The modifier keyword (e.g. "SQL_CALC_FOUND_ROWS").
+func (m SelectModifier) String() string {
+	var sign string
+
+	switch m {
+	case HighPriority:
+		sign = "HIGH_PRIORITY"
+	case StraightJoin:
+		sign = "STRAIGHT_JOIN"
+	case SqlSmallResult:
+		sign = "SQL_SMALL_RESULT"
+	case SqlBigResult:
+		sign = "SQL_BIG_RESULT"
+	case SqlBufferResult:
+		sign = "SQL_BUFFER_RESULT"
+	case SqlNoCache:
+		sign = "SQL_NO_CACHE"
+	case SqlCalcFoundRows:
+		sign = "SQL_CALC_FOUND_ROWS"
+	}
+
+	return sign
+}
+
 // Select clause
 type Select struct {
-	// Columns type string or a QueryBuilder
+	// Columns type string, Case, FieldYear, WindowFunction, Aggregate, Expr, a QueryBuilder or a CompoundBuilder
 	Columns []any
+	// Distinct removes duplicate rows from the result (SELECT DISTINCT).
+	Distinct bool
+	// DistinctOn keeps the first row of each set of rows where the expressions are equal (PostgreSQL, ClickHouse).
+	DistinctOn []string
+	// Modifiers holds the MySQL select modifiers (HIGH_PRIORITY, SQL_CALC_FOUND_ROWS, ...).
+	Modifiers []SelectModifier
+}
+
+// keyword generates the SELECT keyword followed by the DISTINCT option and the select modifiers.
+//
+// Returns:
+// - string: E.g. "SELECT DISTINCT ON (department_id)" or "SELECT SQL_CALC_FOUND_ROWS".
+func (s *Select) keyword() string {
+	parts := []string{"SELECT"}
+
+	if len(s.DistinctOn) > 0 {
+		parts = append(parts, fmt.Sprintf("DISTINCT ON (%s)", strings.Join(s.DistinctOn, ", ")))
+	} else if s.Distinct {
+		parts = append(parts, "DISTINCT")
+	}
+
+	// Render the modifiers in the order required by the MySQL syntax.
+	modifiers := append([]SelectModifier(nil), s.Modifiers...)
+	sort.Slice(modifiers, func(i, j int) bool {
+		return modifiers[i] < modifiers[j]
+	})
+
+	for _, modifier := range modifiers {
+		parts = append(parts, modifier.String())
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// validate checks the DISTINCT option and the select modifiers against the dialect.
+//
+// Parameters:
+// - d Dialect: The dialect of the builder, the default dialect when nil.
+//
+// Returns:
+// - error: ErrNotSupported if DISTINCT ON is used outside PostgreSQL and ClickHouse or modifiers are used outside MySQL.
+func (s *Select) validate(d Dialect) error {
+	if len(s.DistinctOn) > 0 && !isDialect(d, PostgreSQL) && !isDialect(d, ClickHouse) {
+		return fmt.Errorf("%w: %s DISTINCT ON", ErrNotSupported, dialectOr(d).Name())
+	}
+
+	if len(s.Modifiers) > 0 && !isDialect(d, MySQL) {
+		return fmt.Errorf("%w: %s select modifier %s", ErrNotSupported, dialectOr(d).Name(), s.Modifiers[0])
+	}
+
+	return nil
 }
 
 // String generates the SQL SELECT statement based on the columns provided in the Select struct.
@@ -17,6 +110,11 @@ type Select struct {
 // Returns:
 // - A string representing the constructed SQL SELECT statement.
 func (s *Select) String() string {
+	return s.render(nil)
+}
+
+// render generates the SQL of String with the dialect d, the default dialect when nil.
+func (s *Select) render(d Dialect) string {
 	// Default SELECT clause to "*"
 	selectOf := "*"
 
@@ -27,20 +125,35 @@ func (s *Select) String() string {
 		// Process each column in Columns
 		for _, col := range s.Columns {
 			if valueCase, ok := col.(*Case); ok { // Column is of type Case
-				columns = append(columns, valueCase.String())
+				columns = append(columns, valueCase.render(d))
 			} else if valueString, ok := col.(string); ok { // Column is a plain string
 				columns = append(columns, valueString)
 			} else if valueFieldYear, ok := col.(FieldYear); ok { // Column is of type FieldYear
-				columns = append(columns, valueFieldYear.String())
+				columns = append(columns, valueFieldYear.render(d))
+			} else if valueWindow, ok := col.(*WindowFunction); ok { // Column is a window function
+				columns = append(columns, valueWindow.render(d))
+			} else if valueAggregate, ok := col.(*Aggregate); ok { // Column is an aggregate, with its alias
+				columns = append(columns, valueAggregate.render(d)+valueAggregate.alias())
 			} else if valueQueryBuilder, ok := col.(*QueryBuilder); ok { // Column is a QueryBuilder
-				selectQuery := valueQueryBuilder.String()
+				selectQuery := valueQueryBuilder.render(d)
 
 				// Add parentheses if alias is not provided
 				if valueQueryBuilder.alias == "" {
-					selectQuery = fmt.Sprintf("(%s)", valueQueryBuilder)
+					selectQuery = fmt.Sprintf("(%s)", selectQuery)
+				}
+
+				columns = append(columns, selectQuery)
+			} else if valueCompound, ok := col.(*CompoundBuilder); ok { // Column is a CompoundBuilder
+				selectQuery := valueCompound.render(d)
+
+				// Add parentheses if alias is not provided
+				if valueCompound.alias == "" {
+					selectQuery = fmt.Sprintf("(%s)", selectQuery)
 				}
 
 				columns = append(columns, selectQuery)
+			} else if valueExpr, ok := col.(Expr); ok { // Column is an expression, e.g. an Ident
+				columns = append(columns, valueExpr.render(d))
 			}
 		}
 
@@ -49,5 +162,5 @@ func (s *Select) String() string {
 	}
 
 	// Return the constructed SQL SELECT statement
-	return fmt.Sprintf("SELECT %s", selectOf)
+	return fmt.Sprintf("%s %s", s.keyword(), selectOf)
 }
@@ -46,3 +46,42 @@ func TestColumnFromSelect(t *testing.T) {
 		t.Fatalf(`Query %s != %s`, selectTest.String(), expected)
 	}
 }
+
+// TestSelectDistinct
+func TestSelectDistinct(t *testing.T) {
+	selectTest := new(Select)
+
+	selectTest.Columns = []any{"salary"}
+	selectTest.Distinct = true
+	expected := "SELECT DISTINCT salary"
+
+	if selectTest.String() != expected {
+		t.Fatalf(`Query %s != %s`, selectTest.String(), expected)
+	}
+
+	selectTest.DistinctOn = []string{"department_id", "job_id"}
+	expected = "SELECT DISTINCT ON (department_id, job_id) salary"
+
+	if selectTest.String() != expected {
+		t.Fatalf(`Query %s != %s`, selectTest.String(), expected)
+	}
+}
+
+// TestSelectModifiers
+func TestSelectModifiers(t *testing.T) {
+	selectTest := new(Select)
+
+	selectTest.Columns = []any{"id"}
+	selectTest.Distinct = true
+	selectTest.Modifiers = []SelectModifier{SqlCalcFoundRows, HighPriority, SqlNoCache, StraightJoin}
+	expected := "SELECT DISTINCT HIGH_PRIORITY STRAIGHT_JOIN SQL_NO_CACHE SQL_CALC_FOUND_ROWS id"
+
+	if selectTest.String() != expected {
+		t.Fatalf(`Query %s != %s`, selectTest.String(), expected)
+	}
+
+	sql, _ := selectTest.StringArgs(nil)
+	if sql != expected {
+		t.Fatalf(`Query %s != %s`, sql, expected)
+	}
+}
@@ -0,0 +1,114 @@
+package fluentsql
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SettingItem represents a single query-level setting.
+type SettingItem struct {
+	Key   string // Key is the name of the setting, e.g. "max_threads".
+	Value any    // Value is the value of the setting, a string, number or bool.
+}
+
+// value generates the literal of the setting value. Settings only accept literals, so values are never bound.
+//
+// Parameters:
+//   - d: The dialect of the builder, the default dialect when nil.
+//
+// Returns:
+//   - string: The value, strings are quoted and escaped by the dialect.
+//   - error: An error when the value cannot be written as a literal, ErrUnsafeIdent when the name of the setting
+//     is not a plain identifier.
+func (s *SettingItem) value(d Dialect) (string, error) {
+	// The name is written as is
+	if err := checkIdents(s.Key); err != nil {
+		return "", err
+	}
+
+	return literal(d, s.Value)
+}
+
+// Settings clause represents the ClickHouse SETTINGS clause placed at the end of a SELECT statement.
+//
+// Syntax:
+//
+//	SETTINGS key = value [, key = value] ...
+type Settings struct {
+	Items []SettingItem
+}
+
+// Append adds a setting to the SETTINGS clause.
+//
+// Parameters:
+//   - key string: The name of the setting.
+//   - value any: The value of the setting.
+func (s *Settings) Append(key string, value any) {
+	s.Items = append(s.Items, SettingItem{Key: key, Value: value})
+}
+
+// get returns the value of a setting.
+//
+// Parameters:
+//   - key string: The name of the setting.
+//
+// Returns:
+//   - any: The value of the last matching setting, nil if the setting is not set.
+func (s *Settings) get(key string) any {
+	var value any
+
+	for _, item := range s.Items {
+		if item.Key == key {
+			value = item.Value
+		}
+	}
+
+	return value
+}
+
+// settings generates the SETTINGS clause for the dialect.
+//
+// Parameters:
+//   - d: The dialect of the builder, the default dialect when nil.
+//
+// Returns:
+//   - string: The SETTINGS clause. Returns an empty string if no setting is set.
+//   - error: ErrNotSupported when the dialect is not ClickHouse, or the error of a setting.
+func (s *Settings) settings(d Dialect) (string, error) {
+	if len(s.Items) == 0 {
+		return "", nil
+	}
+
+	if !isDialect(d, ClickHouse) {
+		return "", fmt.Errorf("%w: %s SETTINGS", ErrNotSupported, dialectOr(d).Name())
+	}
+
+	var items []string
+	for _, item := range s.Items {
+		value, err := item.value(d)
+		if err != nil {
+			return "", err
+		}
+
+		items = append(items, fmt.Sprintf("%s = %s", item.Key, value))
+	}
+
+	return fmt.Sprintf("SETTINGS %s", strings.Join(items, ", ")), nil
+}
+
+// String generates the SETTINGS clause for the current dialect.
+// The clause is omitted when the dialect is not ClickHouse, or when a setting is invalid;
+// use StringArgs to get an error instead.
+//
+// Returns:
+//   - string: E.g. "SETTINGS max_threads = 8, join_algorithm = 'hash'". Returns an empty string if no setting is set.
+func (s *Settings) String() string {
+	return s.render(nil)
+}
+
+// render generates the SQL of String with the dialect d, the default dialect when nil.
+func (s *Settings) render(d Dialect) string {
+	sql, _ := s.settings(d)
+
+	return sql
+}
@@ -0,0 +1,67 @@
+package fluentsql
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestSettings
+func TestSettings(t *testing.T) {
+	originalDialect := defaultDialect
+	defer func() {
+		defaultDialect = originalDialect
+	}()
+
+	SetDialect(new(ClickHouseDialect))
+
+	testCases := map[string]Settings{
+		"":                               {},
+		"SETTINGS max_threads = 8":       {Items: []SettingItem{{"max_threads", 8}}},
+		"SETTINGS final = true":          {Items: []SettingItem{{"final", true}}},
+		"SETTINGS log_comment = 'it''s'": {Items: []SettingItem{{"log_comment", "it's"}}},
+		"SETTINGS max_threads = 8, join_algorithm = 'hash'": {Items: []SettingItem{
+			{"max_threads", 8},
+			{"join_algorithm", "hash"},
+		}},
+	}
+
+	for expected, settings := range testCases {
+		if settings.String() != expected {
+			t.Fatalf(`Query %s != %s`, settings.String(), expected)
+		}
+	}
+}
+
+// TestSettingsDialect
+func TestSettingsDialect(t *testing.T) {
+	originalDialect := defaultDialect
+	defer func() {
+		defaultDialect = originalDialect
+	}()
+
+	settings := Settings{}
+	settings.Append("max_threads", 8)
+
+	SetDialect(new(MySQLDialect))
+
+	if settings.String() != "" {
+		t.Fatalf("Expected empty SETTINGS clause, got %s", settings.String())
+	}
+
+	if _, _, err := settings.StringArgs(nil); !errors.Is(err, ErrNotSupported) {
+		t.Fatalf("Expected ErrNotSupported, got %v", err)
+	}
+
+	// The names are not quoted, the values are literals
+	SetDialect(new(ClickHouseDialect))
+
+	settings = Settings{Items: []SettingItem{{"max_threads = 1, readonly", 0}}}
+	if _, _, err := settings.StringArgs(nil); !errors.Is(err, ErrUnsafeIdent) {
+		t.Fatalf("Expected ErrUnsafeIdent, got %v", err)
+	}
+
+	settings = Settings{Items: []SettingItem{{"max_threads", struct{}{}}}}
+	if _, _, err := settings.StringArgs(nil); err == nil {
+		t.Fatalf("Expected an error for a setting value without literal")
+	}
+}
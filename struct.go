@@ -0,0 +1,156 @@
+package fluentsql
+
+import (
+	"reflect"
+
+	"github.com/jivegroup/fluentsql/internal/structmap"
+)
+
+// StructOptions configures which fields of a tagged struct are turned into columns.
+//
+// Fields are mapped to columns by their `db` tag, see the Struct methods of the builders:
+//
+//	type User struct {
+//	    ID    int    `db:"id,pk"`
+//	    Name  string `db:"name"`
+//	    Notes string `db:"-"`
+//	}
+type StructOptions struct {
+	OmitZero       bool     // OmitZero skips the fields holding the zero value of their type.
+	SkipPrimaryKey bool     // SkipPrimaryKey skips the fields tagged with the "pk" option, or the "id" column if none is tagged.
+	Include        []string // Include restricts the columns to the listed column names.
+	Exclude        []string // Exclude skips the listed column names.
+}
+
+// structOptions returns the first options of a variadic parameter.
+//
+// Parameters:
+//   - opts: The optional StructOptions.
+//
+// Returns:
+//   - StructOptions: The options, the zero value if none is given.
+func structOptions(opts []StructOptions) StructOptions {
+	if len(opts) > 0 {
+		return opts[0]
+	}
+
+	return StructOptions{}
+}
+
+// contains reports whether a column name is in a list.
+func contains(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// primaryKey reports whether a field is the primary key of its struct.
+//
+// Parameters:
+//   - meta: The struct mapping.
+//   - field: The field to check.
+//
+// Returns:
+//   - bool: true if the field is tagged with "pk", or is the "id" column when no field is tagged.
+func primaryKey(meta *structmap.Struct, field *structmap.Field) bool {
+	for _, f := range meta.Fields {
+		if f.HasOption("pk") {
+			return field.HasOption("pk")
+		}
+	}
+
+	return field.Name == "id"
+}
+
+// structFields selects the fields of a struct turned into columns.
+//
+// Parameters:
+//   - v: The struct value, a struct or a pointer to a struct. An invalid value ignores OmitZero.
+//   - meta: The struct mapping.
+//   - opts: The selection options.
+//
+// Returns:
+//   - []*structmap.Field: The selected fields in declaration order.
+func structFields(v reflect.Value, meta *structmap.Struct, opts StructOptions) []*structmap.Field {
+	var fields []*structmap.Field
+
+	for _, field := range meta.Fields {
+		if len(opts.Include) > 0 && !contains(opts.Include, field.Name) {
+			continue
+		}
+
+		if contains(opts.Exclude, field.Name) {
+			continue
+		}
+
+		if opts.SkipPrimaryKey && primaryKey(meta, field) {
+			continue
+		}
+
+		if opts.OmitZero && v.IsValid() {
+			if value, ok := field.Value(v); !ok || value.IsZero() {
+				continue
+			}
+		}
+
+		fields = append(fields, field)
+	}
+
+	return fields
+}
+
+// structValue returns the value of a field of a struct.
+//
+// Parameters:
+//   - v: The struct value.
+//   - field: The field.
+//
+// Returns:
+//   - any: The field value. Nil pointers, including nil embedded structs, are returned as nil.
+func structValue(v reflect.Value, field *structmap.Field) any {
+	value, ok := field.Value(v)
+	if !ok {
+		return nil
+	}
+
+	if value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return nil
+		}
+
+		value = value.Elem()
+	}
+
+	return value.Interface()
+}
+
+// structOf returns the struct value and mapping of a tagged struct.
+//
+// Parameters:
+//   - v: A struct or a pointer to a struct.
+//
+// Returns:
+//   - reflect.Value: The struct value, invalid for a nil pointer.
+//   - *structmap.Struct: The struct mapping. Returns nil if v is not a struct.
+func structOf(v any) (reflect.Value, *structmap.Struct) {
+	value := reflect.ValueOf(v)
+	if !value.IsValid() {
+		return value, nil
+	}
+
+	meta := structmap.Of(value.Type())
+
+	for value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return reflect.Value{}, meta
+		}
+
+		value = value.Elem()
+	}
+
+	return value, meta
+}
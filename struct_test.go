@@ -0,0 +1,84 @@
+package fluentsql
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+type structAudit struct {
+	CreatedAt time.Time `db:"created_at"`
+}
+
+type structUser struct {
+	structAudit
+	ID       int     `db:"id,pk"`
+	Name     string  `db:"name"`
+	Email    *string `db:"email"`
+	Age      int     `db:"age"`
+	Password string  `db:"-"`
+}
+
+type structCountry struct {
+	ID   string `db:"id"`
+	Name string `db:"name"`
+}
+
+// columnNames returns the column names of the fields selected from v.
+func columnNames(v any, opts StructOptions) []string {
+	value, meta := structOf(v)
+
+	var names []string
+	for _, field := range structFields(value, meta, opts) {
+		names = append(names, field.Name)
+	}
+
+	return names
+}
+
+// TestStructFields
+func TestStructFields(t *testing.T) {
+	user := structUser{ID: 1, Name: "Ann"}
+
+	testCases := []struct {
+		opts     StructOptions
+		expected []string
+	}{
+		{StructOptions{}, []string{"id", "name", "email", "age", "created_at"}},
+		{StructOptions{SkipPrimaryKey: true}, []string{"name", "email", "age", "created_at"}},
+		{StructOptions{OmitZero: true}, []string{"id", "name"}},
+		{StructOptions{Include: []string{"name", "age"}}, []string{"name", "age"}},
+		{StructOptions{Exclude: []string{"created_at", "email"}}, []string{"id", "name", "age"}},
+	}
+
+	for _, testCase := range testCases {
+		names := columnNames(&user, testCase.opts)
+		if !reflect.DeepEqual(names, testCase.expected) {
+			t.Fatalf("Columns %v != %v (%+v)", names, testCase.expected, testCase.opts)
+		}
+	}
+
+	// The "id" column is the primary key when no field is tagged with "pk"
+	names := columnNames(structCountry{ID: "VN", Name: "Vietnam"}, StructOptions{SkipPrimaryKey: true})
+	if !reflect.DeepEqual(names, []string{"name"}) {
+		t.Fatalf("Columns %v != [name]", names)
+	}
+}
+
+// TestStructValue
+func TestStructValue(t *testing.T) {
+	email := "ann@example.com"
+	value, meta := structOf(&structUser{Name: "Ann", Email: &email})
+
+	field, _ := meta.Lookup("email")
+	if structValue(value, field) != email {
+		t.Fatalf("Expected the dereferenced pointer, got %v", structValue(value, field))
+	}
+
+	value, meta = structOf(structUser{})
+
+	field, _ = meta.Lookup("email")
+	if structValue(value, field) != nil {
+		t.Fatalf("Expected nil, got %v", structValue(value, field))
+	}
+}
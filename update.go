@@ -16,8 +16,13 @@ type Update struct {
 // Returns:
 //   - A string containing the formatted UPDATE statement.
 func (u *Update) String() string {
+	return u.render(nil)
+}
+
+// render generates the SQL of String with the dialect d, the default dialect when nil.
+func (u *Update) render(d Dialect) string {
 	var sb strings.Builder // Used to efficiently build the SQL string.
-	sb.WriteString(fmt.Sprintf("UPDATE %s", u.Table))
+	sb.WriteString(fmt.Sprintf("UPDATE %s", dialectField(d, u.Table)))
 
 	if u.Alias != "" { // Add table alias to the statement if specified.
 		sb.WriteString(" " + u.Alias)
@@ -38,13 +43,18 @@ type UpdateItem struct {
 // Returns:
 //   - A string representing the SET clause for the update field and value.
 func (s *UpdateItem) String() string {
+	return s.render(nil)
+}
+
+// render generates the SQL of String with the dialect d, the default dialect when nil.
+func (s *UpdateItem) render(d Dialect) string {
 	// SET (field1, field2,...) = (int, string, ValueField...)
 	// SET (field1, field2,...) = (SELECT * FROM table_name)
 	if fieldStringSlice, ok := s.Field.([]string); ok { // Check if the field is of type []string.
 		fieldStr := joinSlice(fieldStringSlice, ",") // Combine fields into a single comma-separated string.
 
 		if valueQueryBuilder, ok := s.Value.(*QueryBuilder); ok { // Check if the value is a QueryBuilder.
-			return fmt.Sprintf("(%s) = (%v)", fieldStr, valueQueryBuilder)
+			return fmt.Sprintf("(%s) = (%v)", fieldStr, valueQueryBuilder.render(d))
 		}
 
 		if fieldAnySlice, ok := s.Value.([]any); ok { // Check if the value is a slice of any type.
@@ -52,10 +62,8 @@ func (s *UpdateItem) String() string {
 			for _, fieldAny := range fieldAnySlice {
 				if valueField, ok := fieldAny.(IValueField); ok { // If the value is of ValueField type.
 					values = append(values, valueField.Value())
-				} else if valueString, ok := fieldAny.(string); ok { // If the value is of string type.
-					values = append(values, "'"+valueString+"'")
-				} else { // If the value is of numeric type.
-					values = append(values, fmt.Sprintf("%v", fieldAny))
+				} else { // If the value is a literal.
+					values = append(values, inline(d, fieldAny))
 				}
 			}
 
@@ -68,18 +76,19 @@ func (s *UpdateItem) String() string {
 	}
 
 	if valueQueryBuilder, ok := s.Value.(*QueryBuilder); ok { // Check if the value is a QueryBuilder.
-		return fmt.Sprintf("%s = (%v)", s.Field, valueQueryBuilder)
+		return fmt.Sprintf("%s = (%v)", dialectField(d, s.Field), valueQueryBuilder.render(d))
 	}
 
-	if valueField, ok := s.Value.(IValueField); ok { // Check if the value is a ValueField.
-		return fmt.Sprintf("%s = %s", s.Field, valueField.Value())
+	if valueExpr, ok := s.Value.(Expr); ok { // Check if the value is an expression.
+		return fmt.Sprintf("%s = %s", dialectField(d, s.Field), valueExpr.render(d))
 	}
 
-	if valueString, ok := s.Value.(string); ok { // Check if the value is a string.
-		return fmt.Sprintf("%s = '%s'", s.Field, valueString)
+	if valueField, ok := s.Value.(IValueField); ok { // Check if the value is a ValueField.
+		return fmt.Sprintf("%s = %s", dialectField(d, s.Field), valueField.Value())
 	}
 
-	return fmt.Sprintf("%s = %v", s.Field, s.Value) // Default fallback for numeric types.
+	// Default fallback for literal values, a nil value sets the column to NULL.
+	return fmt.Sprintf("%s = %s", dialectField(d, s.Field), inline(d, s.Value))
 }
 
 type UpdateSet struct {
@@ -111,10 +120,15 @@ func (s *UpdateSet) AppendItems(items ...UpdateItem) {
 // Returns:
 //   - A string representing the full SET clause of the update statement.
 func (s *UpdateSet) String() string {
+	return s.render(nil)
+}
+
+// render generates the SQL of String with the dialect d, the default dialect when nil.
+func (s *UpdateSet) render(d Dialect) string {
 	var setColumns []string // A slice to hold the string representations of each update assignment.
 
 	for _, item := range s.Items {
-		setColumns = append(setColumns, item.String())
+		setColumns = append(setColumns, item.render(d))
 	}
 
 	return fmt.Sprintf("SET %s", strings.Join(setColumns, ", ")) // Concatenates all assignments into a single string.
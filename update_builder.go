@@ -27,6 +27,10 @@ import "strings"
 //
 //	assignment [, assignment] ...
 type UpdateBuilder struct {
+	// dialect overrides the default dialect when set. Subqueries use the dialect of the statement.
+	dialect Dialect
+	// withStatement represents the WITH clause placed before the UPDATE statement.
+	withStatement With
 	// updateStatement represents the UPDATE clause of the SQL statement.
 	updateStatement Update
 	// setStatement represents the SET clause of the SQL statement.
@@ -37,6 +41,8 @@ type UpdateBuilder struct {
 	orderByStatement OrderBy
 	// limitStatement represents the LIMIT clause of the SQL statement.
 	limitStatement Limit
+	// returningStatement represents the RETURNING clause of the SQL statement.
+	returningStatement Returning
 }
 
 // UpdateInstance Update Builder constructor
@@ -57,23 +63,43 @@ func UpdateInstance() *UpdateBuilder {
 // Returns:
 // - A string containing the complete SQL query.
 func (ub *UpdateBuilder) String() string {
+	return ub.render(ub.dialect)
+}
+
+// render generates the SQL of String with the dialect d, the dialect of the enclosing
+// statement for a subquery. The default dialect is used when d is nil.
+func (ub *UpdateBuilder) render(d Dialect) string {
+	d = dialectOr(d)
+
 	var queryParts []string // Holds different parts of the SQL query.
 
+	// Add WITH clause if available.
+	withSql := ub.withStatement.render(d)
+	if withSql != "" {
+		queryParts = append(queryParts, withSql)
+	}
+
 	// Add UPDATE clause to the query parts.
 	// Add SET clause to the query parts.
 	queryParts = append(queryParts,
-		ub.updateStatement.String(),
-		ub.setStatement.String(),
+		ub.updateStatement.render(d),
+		ub.setStatement.render(d),
 	)
 
+	// Add OUTPUT clause if available.
+	outputSql := ub.returningStatement.output(d, "inserted")
+	if outputSql != "" {
+		queryParts = append(queryParts, outputSql)
+	}
+
 	// Add WHERE clause if available.
-	whereSql := ub.whereStatement.String()
+	whereSql := ub.whereStatement.render(d)
 	if whereSql != "" {
 		queryParts = append(queryParts, whereSql)
 	}
 
 	// Add ORDER BY clause if available.
-	orderBySql := ub.orderByStatement.String()
+	orderBySql := ub.orderByStatement.render(d)
 	if orderBySql != "" {
 		queryParts = append(queryParts, orderBySql)
 	}
@@ -84,12 +110,66 @@ func (ub *UpdateBuilder) String() string {
 		queryParts = append(queryParts, limitSql)
 	}
 
+	// Add RETURNING clause if available.
+	returningSql := ub.returningStatement.render(d)
+	if returningSql != "" {
+		queryParts = append(queryParts, returningSql)
+	}
+
 	// Join all SQL parts into a single SQL query string.
 	sql := strings.Join(queryParts, " ")
 
 	return sql
 }
 
+// With adds a common table expression to the WITH clause placed before the statement.
+//
+// Parameters:
+//   - name (string): The name of the CTE.
+//   - query (any): The body of the CTE, a *QueryBuilder or *CompoundBuilder.
+//   - columns (...string): Optional column names of the CTE.
+//
+// Returns:
+//   - *UpdateBuilder: The current UpdateBuilder instance.
+func (ub *UpdateBuilder) With(name string, query any, columns ...string) *UpdateBuilder {
+	ub.withStatement.Append(CTE{
+		Name:    name,
+		Columns: columns,
+		Query:   query,
+	})
+
+	return ub
+}
+
+// WithRecursive adds a common table expression and marks the WITH clause as RECURSIVE.
+// The keyword is omitted under SQL Server and Oracle, whose CTEs are recursive without it.
+//
+// Parameters:
+//   - name (string): The name of the CTE.
+//   - query (any): The body of the CTE, a *QueryBuilder or *CompoundBuilder.
+//   - columns (...string): Optional column names of the CTE.
+//
+// Returns:
+//   - *UpdateBuilder: The current UpdateBuilder instance.
+func (ub *UpdateBuilder) WithRecursive(name string, query any, columns ...string) *UpdateBuilder {
+	ub.withStatement.Recursive = true
+
+	return ub.With(name, query, columns...)
+}
+
+// WithCTE appends prepared common table expressions to the WITH clause.
+//
+// Parameters:
+//   - ctes (...CTE): The CTEs to be added.
+//
+// Returns:
+//   - *UpdateBuilder: The current UpdateBuilder instance.
+func (ub *UpdateBuilder) WithCTE(ctes ...CTE) *UpdateBuilder {
+	ub.withStatement.Append(ctes...)
+
+	return ub
+}
+
 // Update sets the table and optional alias for the UPDATE clause.
 // Parameters:
 // - table (any): The table to be updated.
@@ -183,3 +263,69 @@ func (ub *UpdateBuilder) WhereCondition(conditions ...Condition) *UpdateBuilder
 
 	return ub
 }
+
+// OrderBy adds a field to the ORDER BY clause, which sets the order of the updated rows (MySQL).
+// Parameters:
+// - field (any): The field to sort by, a string, an Ident or an expression.
+// - dir (OrderByDir): The direction of sorting (ASC or DESC).
+// Returns:
+// - *UpdateBuilder: The current UpdateBuilder instance.
+func (ub *UpdateBuilder) OrderBy(field any, dir OrderByDir) *UpdateBuilder {
+	ub.orderByStatement.Append(field, dir)
+
+	return ub
+}
+
+// OrderBySort appends sort items with a NULLS position or a collation to the ORDER BY clause.
+// Parameters:
+// - items (...SortItem): The sort items, e.g. Sort("created_at", Asc).NullsFirst().
+// Returns:
+// - *UpdateBuilder: The current UpdateBuilder instance.
+func (ub *UpdateBuilder) OrderBySort(items ...SortItem) *UpdateBuilder {
+	ub.orderByStatement.Items = append(ub.orderByStatement.Items, items...)
+
+	return ub
+}
+
+// Returning adds columns or expressions to the RETURNING clause (PostgreSQL, SQLite).
+// Parameters:
+// - columns (...string): The columns or expressions to be returned, "*" returns every column.
+// Returns:
+// - *UpdateBuilder: The current UpdateBuilder instance.
+func (ub *UpdateBuilder) Returning(columns ...string) *UpdateBuilder {
+	ub.returningStatement.Append(columns...)
+
+	return ub
+}
+
+// SetStruct adds an assignment to the SET clause for every `db` tagged field of a struct.
+// Parameters:
+// - v (any): A struct or a pointer to a struct.
+// - opts (...StructOptions): Optional selection of the columns, e.g. StructOptions{SkipPrimaryKey: true, OmitZero: true}.
+// Returns:
+// - *UpdateBuilder: The current UpdateBuilder instance.
+func (ub *UpdateBuilder) SetStruct(v any, opts ...StructOptions) *UpdateBuilder {
+	value, meta := structOf(v)
+	if meta == nil || !value.IsValid() {
+		return ub
+	}
+
+	for _, field := range structFields(value, meta, structOptions(opts)) {
+		ub.setStatement.Append(field.Name, structValue(value, field))
+	}
+
+	return ub
+}
+
+// SetDialect sets the dialect used to generate the statement instead of the default dialect.
+// Subqueries and CTEs are generated with the same dialect.
+// Sql returns ErrNotSupported for one with a different dialect of its own.
+// Parameters:
+// - dialect (Dialect): The database dialect, e.g. new(MySQLDialect).
+// Returns:
+// - *UpdateBuilder: The current UpdateBuilder instance.
+func (ub *UpdateBuilder) SetDialect(dialect Dialect) *UpdateBuilder {
+	ub.dialect = dialect
+
+	return ub
+}
@@ -6,39 +6,135 @@ import (
 )
 
 // Sql generates the SQL query string and its corresponding arguments.
-func (ub *UpdateBuilder) Sql() (string, []any, interface{}) {
+func (ub *UpdateBuilder) Sql() (string, []any, error) {
 	return ub.StringArgs()
 }
 
+// Interpolate generates the SQL query string of Sql with its arguments inlined as literals escaped for the dialect,
+// e.g. for logging or debugging. Returns the error of Sql, or an error when an argument cannot be inlined.
+func (ub *UpdateBuilder) Interpolate() (string, error) {
+	sql, args, err := ub.Sql()
+	if err != nil {
+		return "", err
+	}
+
+	return interpolate(ub.dialect, sql, args)
+}
+
+// Compile generates the statement once, for executions binding its named parameters with Bind.
+//
+// Returns:
+// - *Compiled: The compiled statement.
+// - error: The error of Sql.
+func (ub *UpdateBuilder) Compile() (*Compiled, error) {
+	return compile(ub.Sql())
+}
+
+// SqlParams generates the statement of Sql with its named parameters bound, see Compiled.Bind.
+//
+// Parameters:
+// - params any: The values of the parameters, a map[string]any or a tagged struct.
+//
+// Returns:
+// - string: The statement with placeholders.
+// - []any: The arguments of the statement.
+// - error: The error of Sql, or of binding the parameters.
+func (ub *UpdateBuilder) SqlParams(params any) (string, []any, error) {
+	compiled, err := ub.Compile()
+	if err != nil {
+		return "", nil, err
+	}
+
+	args, err := compiled.Bind(params)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return compiled.Sql(), args, nil
+}
+
 // StringArgs constructs the SQL query string and collects the argument values.
 // Returns the SQL query string, the list of arguments, and an error if any occurred.
 func (ub *UpdateBuilder) StringArgs() (string, []any, error) {
+	var args []any // A slice of arguments to be used in the query.
+
+	if isStrict(ub.dialect) {
+		if err := ub.checkIdents(); err != nil {
+			return "", args, err
+		}
+	}
+
+	return ub.renderArgs(ub.dialect, args)
+}
+
+// renderArgs generates the SQL of StringArgs with the dialect d, the dialect of the enclosing statement for a subquery.
+// The default dialect is used when d is nil.
+func (ub *UpdateBuilder) renderArgs(d Dialect, args []any) (string, []any, error) {
 	var queryParts []string // Holds different parts of the SQL query.
 	var sql string          // The final SQL query string.
-	var args []any          // A slice of arguments to be used in the query.
+	var err error
+
+	if err := checkDialect(ub.dialect, d); err != nil {
+		return "", args, err
+	}
+
+	d = dialectOr(d)
+
+	// Add WITH clause if present.
+	sql, args, err = ub.withStatement.renderArgs(d, args)
+	if err != nil {
+		return "", args, err
+	}
+	if sql != "" {
+		queryParts = append(queryParts, sql)
+	}
 
 	// Add UPDATE statement.
-	sql, args = ub.updateStatement.StringArgs(args)
+	sql, args = ub.updateStatement.renderArgs(d, args)
 	queryParts = append(queryParts, sql)
 
 	// Add SET statement.
-	sql, args = ub.setStatement.StringArgs(args)
+	sql, args, err = ub.setStatement.renderArgs(d, args)
+	if err != nil {
+		return "", args, err
+	}
 	queryParts = append(queryParts, sql)
 
+	// Add OUTPUT clause if present.
+	sql = ub.returningStatement.output(d, "inserted")
+	if sql != "" {
+		queryParts = append(queryParts, sql)
+	}
+
 	// Add WHERE clause if present.
-	sql, args = ub.whereStatement.StringArgs(args)
+	sql, args, err = ub.whereStatement.renderArgs(d, args)
+	if err != nil {
+		return "", args, err
+	}
 	if sql != "" {
 		queryParts = append(queryParts, sql)
 	}
 
 	// Add ORDER BY clause if present.
-	sql, args = ub.orderByStatement.StringArgs(args)
+	sql, args, err = ub.orderByStatement.renderArgs(d, args)
+	if err != nil {
+		return "", args, err
+	}
 	if sql != "" {
 		queryParts = append(queryParts, sql)
 	}
 
 	// Add LIMIT clause if present.
-	sql, args = ub.limitStatement.StringArgs(args)
+	sql, args = ub.limitStatement.renderArgs(d, args)
+	if sql != "" {
+		queryParts = append(queryParts, sql)
+	}
+
+	// Add RETURNING clause if present.
+	sql, args, err = ub.returningStatement.renderArgs(d, args)
+	if err != nil {
+		return "", args, err
+	}
 	if sql != "" {
 		queryParts = append(queryParts, sql)
 	}
@@ -57,15 +153,12 @@ func (ub *UpdateBuilder) StringArgs() (string, []any, error) {
 // - A formatted SQL UPDATE string.
 // - A slice of arguments.
 func (u *Update) StringArgs(args []any) (string, []any) {
-	var sb strings.Builder // Used for efficient string concatenation.
-	sb.WriteString(fmt.Sprintf("UPDATE %s", u.Table))
-
-	// Add table alias if present.
-	if u.Alias != "" {
-		sb.WriteString(" " + u.Alias)
-	}
+	return u.renderArgs(nil, args)
+}
 
-	return sb.String(), args
+// renderArgs generates the SQL of StringArgs with the dialect d, the default dialect when nil.
+func (u *Update) renderArgs(d Dialect, args []any) (string, []any) {
+	return u.render(d), args
 }
 
 // StringArgs generates the SQL fragment for the SET clause and appends to provided arguments.
@@ -75,19 +168,31 @@ func (u *Update) StringArgs(args []any) (string, []any) {
 // Returns:
 // - A formatted SQL SET string.
 // - A slice of arguments.
+// - The error of a subquery or an expression value.
 func (s *UpdateSet) StringArgs(args []any) (string, []any) {
+	sql, args, _ := s.renderArgs(nil, args)
+
+	return sql, args
+}
+
+// renderArgs generates the SQL of StringArgs with the dialect d, the default dialect when nil.
+func (s *UpdateSet) renderArgs(d Dialect, args []any) (string, []any, error) {
 	var setColumns []string // Holds the individual SET assignments.
 
 	// Process each item in the SET clause.
 	for _, item := range s.Items {
 		var sql string
+		var err error
 
-		sql, args = item.StringArgs(args)
+		sql, args, err = item.renderArgs(d, args)
+		if err != nil {
+			return "", args, err
+		}
 
 		setColumns = append(setColumns, sql)
 	}
 
-	return fmt.Sprintf("SET %s", strings.Join(setColumns, ", ")), args
+	return fmt.Sprintf("SET %s", strings.Join(setColumns, ", ")), args, nil
 }
 
 // StringArgs generates the SQL fragment for an individual assignment in the SET clause.
@@ -97,7 +202,17 @@ func (s *UpdateSet) StringArgs(args []any) (string, []any) {
 // Returns:
 // - A formatted SQL string for the assignment.
 // - A slice of arguments.
+// - The error of a subquery or an expression value.
 func (s *UpdateItem) StringArgs(args []any) (string, []any) {
+	sql, args, _ := s.renderArgs(nil, args)
+
+	return sql, args
+}
+
+// renderArgs generates the SQL of StringArgs with the dialect d, the default dialect when nil.
+func (s *UpdateItem) renderArgs(d Dialect, args []any) (string, []any, error) {
+	var err error
+
 	// Check if Field is a slice of strings for multi-column updates.
 	// SET (field1, field2,...) = (int, string, ValueField...)
 	// SET (field1, field2,...) = (SELECT * FROM table_name)
@@ -107,9 +222,13 @@ func (s *UpdateItem) StringArgs(args []any) (string, []any) {
 		// If the value is a QueryBuilder, process the associated query.
 		if valueQueryBuilder, ok := s.Value.(*QueryBuilder); ok {
 			var _sql string
-			_sql, args, _ = valueQueryBuilder.StringArgs(args)
 
-			return fmt.Sprintf("(%s) = (%s)", fieldStr, _sql), args
+			_sql, args, err = valueQueryBuilder.renderArgs(d, args)
+			if err != nil {
+				return "", args, err
+			}
+
+			return fmt.Sprintf("(%s) = (%s)", fieldStr, _sql), args, nil
 		}
 
 		// If the value is a slice, process each item in the slice.
@@ -120,12 +239,12 @@ func (s *UpdateItem) StringArgs(args []any) (string, []any) {
 					values = append(values, valueField.Value())
 				} else if valueString, ok := fieldAny.(string); ok {
 					args = append(args, valueString)
-					valueStr := p(args)
+					valueStr := p(d, args)
 
 					values = append(values, valueStr)
 				} else { // Value is an int or float.
 					args = append(args, fieldAny)
-					valueStr := p(args)
+					valueStr := p(d, args)
 
 					values = append(values, valueStr)
 				}
@@ -133,36 +252,52 @@ func (s *UpdateItem) StringArgs(args []any) (string, []any) {
 
 			valueStr := strings.Join(values, ", ")
 
-			return fmt.Sprintf("(%s) = (%v)", fieldStr, valueStr), args
+			return fmt.Sprintf("(%s) = (%v)", fieldStr, valueStr), args, nil
 		}
 
-		return "", args
+		return "", args, nil
 	}
 
 	// If the value is a QueryBuilder, process the associated query.
 	if valueQueryBuilder, ok := s.Value.(*QueryBuilder); ok {
 		var _sql string
-		_sql, args, _ = valueQueryBuilder.StringArgs(args)
 
-		return fmt.Sprintf("%s = (%s)", s.Field, _sql), args
+		_sql, args, err = valueQueryBuilder.renderArgs(d, args)
+		if err != nil {
+			return "", args, err
+		}
+
+		return fmt.Sprintf("%s = (%s)", dialectField(d, s.Field), _sql), args, nil
+	}
+
+	// If the value is an expression, bind its parameters.
+	if valueExpr, ok := s.Value.(Expr); ok {
+		var exprStr string
+
+		exprStr, args, err = valueExpr.renderArgs(d, args)
+		if err != nil {
+			return "", args, err
+		}
+
+		return fmt.Sprintf("%s = %s", dialectField(d, s.Field), exprStr), args, nil
 	}
 
 	// If the value is a ValueField, format it as-is.
 	if valueField, ok := s.Value.(IValueField); ok {
-		return fmt.Sprintf("%s = %s", s.Field, valueField.Value()), args
+		return fmt.Sprintf("%s = %s", dialectField(d, s.Field), valueField.Value()), args, nil
 	}
 
 	// If the value is a string, add it to the arguments and format it.
 	if valueString, ok := s.Value.(string); ok {
 		args = append(args, valueString)
-		valueStr := p(args)
+		valueStr := p(d, args)
 
-		return fmt.Sprintf("%s = %s", s.Field, valueStr), args
+		return fmt.Sprintf("%s = %s", dialectField(d, s.Field), valueStr), args, nil
 	}
 
 	// Default fallback for other types (e.g., int, float).
 	args = append(args, s.Value)
-	valueStr := p(args)
+	valueStr := p(d, args)
 
-	return fmt.Sprintf("%s = %s", s.Field, valueStr), args
+	return fmt.Sprintf("%s = %s", dialectField(d, s.Field), valueStr), args, nil
 }
@@ -1,6 +1,7 @@
 package fluentsql
 
 import (
+	"errors"
 	"testing"
 )
 
@@ -188,3 +189,95 @@ func TestUpdateWhereCondition(t *testing.T) {
 		}
 	}
 }
+
+// TestUpdateWith tests the WITH clause of UpdateBuilder
+func TestUpdateWith(t *testing.T) {
+	query := UpdateInstance().
+		With("vip", QueryInstance().
+			Select("customer_id").
+			From("orders").
+			GroupBy("customer_id").
+			Having("SUM(total)", Greater, 1000),
+		).
+		Update("customers").
+		Set("level", "gold").
+		Where("customer_id", In, QueryInstance().Select("customer_id").From("vip"))
+
+	expected := "WITH vip AS (SELECT customer_id FROM orders GROUP BY customer_id HAVING SUM(total) > 1000) UPDATE customers SET level = 'gold' WHERE customer_id IN (SELECT customer_id FROM vip)"
+	if query.String() != expected {
+		t.Fatalf(`Query %s != %s`, query.String(), expected)
+	}
+
+	sql, args, _ := query.Sql()
+
+	expected = "WITH vip AS (SELECT customer_id FROM orders GROUP BY customer_id HAVING SUM(total) > $1) UPDATE customers SET level = $2 WHERE customer_id IN (SELECT customer_id FROM vip)"
+	if sql != expected {
+		t.Fatalf(`Query %s != %s (%v)`, sql, expected, args)
+	}
+}
+
+// TestUpdateReturning tests the RETURNING clause of UpdateBuilder
+func TestUpdateReturning(t *testing.T) {
+	originalDialect := defaultDialect
+	defer func() {
+		defaultDialect = originalDialect
+	}()
+
+	query := UpdateInstance().
+		Update("employees").
+		Set("salary", 25500).
+		Where("employee_id", Eq, 100).
+		Returning("*")
+
+	if query.String() != "UPDATE employees SET salary = 25500 WHERE employee_id = 100 RETURNING *" {
+		t.Fatalf(`Unexpected query %s`, query.String())
+	}
+
+	sql, args, err := query.Sql()
+
+	expected := "UPDATE employees SET salary = $1 WHERE employee_id = $2 RETURNING *"
+	if err != nil || sql != expected {
+		t.Fatalf(`Query %s != %s (%v)`, sql, expected, err)
+	}
+
+	if len(args) != 2 {
+		t.Fatalf("Unexpected arguments %v", args)
+	}
+
+	SetDialect(new(MySQLDialect))
+
+	if _, _, err = query.Sql(); !errors.Is(err, ErrNotSupported) {
+		t.Fatalf("Expected ErrNotSupported, got %v", err)
+	}
+}
+
+// TestUpdateSetStruct tests the SET clause built from a tagged struct
+func TestUpdateSetStruct(t *testing.T) {
+	user := structUser{ID: 1, Name: "Ann", Age: 31}
+
+	query := UpdateInstance().
+		Update("users").
+		SetStruct(user, StructOptions{SkipPrimaryKey: true, OmitZero: true}).
+		Where("id", Eq, user.ID)
+
+	sql, args, _ := query.Sql()
+
+	expected := "UPDATE users SET name = $1, age = $2 WHERE id = $3"
+	if sql != expected {
+		t.Fatalf(`Query %s != %s (%v)`, sql, expected, args)
+	}
+
+	if len(args) != 3 || args[0] != "Ann" || args[1] != 31 || args[2] != 1 {
+		t.Fatalf("Unexpected arguments %v", args)
+	}
+
+	query = UpdateInstance().
+		Update("users").
+		SetStruct(&user, StructOptions{Include: []string{"email"}}).
+		Where("id", Eq, user.ID)
+
+	expected = "UPDATE users SET email = NULL WHERE id = 1"
+	if query.String() != expected {
+		t.Fatalf(`Query %s != %s`, query.String(), expected)
+	}
+}
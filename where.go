@@ -40,13 +40,18 @@ func (w *Where) Append(conditions ...Condition) {
 //	  WHERE clause representation of conditions will be formatted as:
 //		 WHERE condition1 AND condition2 OR condition3
 func (w *Where) String() string {
+	return w.render(nil)
+}
+
+// render generates the SQL of String with the dialect d, the default dialect when nil.
+func (w *Where) render(d Dialect) string {
 	var conditions []string
 
 	// Loop through each Condition in the Conditions slice.
 	if len(w.Conditions) > 0 {
 		for _, cond := range w.Conditions {
 			// Convert the current condition to its string representation.
-			var _condition = cond.String()
+			var _condition = cond.render(d)
 
 			// If the operator is OR, combine it with the previous condition.
 			if cond.AndOr == Or && len(conditions) > 0 {
@@ -122,6 +127,14 @@ const (
 	LeEqAll                    // Less than or equal to all values in a subquery (<= ALL)
 )
 
+// isRaw checks if the condition is a raw fragment: a RawExpr field with a nil value,
+// other than an IS NULL or IS NOT NULL condition.
+func (c *Condition) isRaw() bool {
+	_, ok := c.Field.(*RawExpr)
+
+	return ok && c.Value == nil && c.Opt != Null && c.Opt != NotNull
+}
+
 // opt determines and returns the SQL operator (e.g., =, >, LIKE) corresponding to the Opt field.
 //
 // Returns:
@@ -206,6 +219,11 @@ func (c *Condition) opt() string {
 // Returns:
 //   - string: A SQL string representation of the condition.
 func (c *Condition) String() string {
+	return c.render(nil)
+}
+
+// render generates the SQL of String with the dialect d, the default dialect when nil.
+func (c *Condition) render(d Dialect) string {
 	// Handle group conditions WhereGroup(groupCondition FnWhereBuilder)
 	if len(c.Group) > 0 {
 		var conditions []string
@@ -213,7 +231,7 @@ func (c *Condition) String() string {
 		// Iterate over the grouped conditions.
 		for _, cond := range c.Group {
 			// Generate the SQL string representation for each condition in the group.
-			var _condition = cond.String()
+			var _condition = cond.render(d)
 
 			// If the logical operator is OR, append the condition with "OR".
 			if cond.AndOr == Or && len(conditions) > 0 {
@@ -237,32 +255,39 @@ func (c *Condition) String() string {
 		return fmt.Sprintf("(%s)", strings.Join(conditions, " AND "))
 	}
 
+	// Handle a raw fragment written as the whole condition.
+	// Example: WHERE created_at > now() - '7 days'::interval
+	if c.isRaw() {
+		return c.Field.(*RawExpr).render(d)
+	}
+
 	// Handle IS NULL and IS NOT NULL conditions.
 	// Example: WHERE Address IS NULL or WHERE Address IS NOT NULL
 	if c.Opt == Null || c.Opt == NotNull {
-		return fmt.Sprintf("%s %s", c.Field, c.opt())
+		return fmt.Sprintf("%s %s", dialectField(d, c.Field), c.opt())
 	}
 
 	// Handle IN and NOT IN conditions.
 	// Example: WHERE Country IN ('Germany', 'France', 'UK')
 	// Example: WHERE Age NOT IN (12, 31, 21)
 	if c.Opt == In || c.Opt == NotIn {
-		// Determine the type of the value being compared (e.g., slice or array).
-		typ := reflect.TypeOf(c.Value)
-
-		if typ.Kind() == reflect.Slice || typ.Kind() == reflect.Array {
-			valuesStr := ""
-			// Convert slice of string values to SQL string format.
-			if values, ok := c.Value.([]string); ok {
-				valuesStr = "'" + strings.Join(values, "', '") + "'"
+		// Expand the values of any slice or array.
+		if values, ok := inValues(c.Value); ok {
+			if len(values) == 0 {
+				return c.emptyIn()
 			}
-			// Convert slice of integer values to comma-separated string format.
-			if values, ok := c.Value.([]int); ok {
-				valuesStr = joinSlice(values, ",")
+
+			if arrayIn(d) {
+				return c.anyArray(dialectField(d, c.Field), inline(d, c.Value))
+			}
+
+			var valuesStr []string
+			for _, value := range values {
+				valuesStr = append(valuesStr, inline(d, value))
 			}
 
 			// Generate the SQL representation.
-			return fmt.Sprintf("%s %s (%s)", c.Field, c.opt(), valuesStr)
+			return fmt.Sprintf("%s %s (%s)", dialectField(d, c.Field), c.opt(), strings.Join(valuesStr, ", "))
 		}
 	}
 
@@ -272,7 +297,11 @@ func (c *Condition) String() string {
 	// WHERE ProductName NOT BETWEEN 'Carnation Tigers' AND 'Mozzarella di Giovanni'
 	// WHERE Price BETWEEN 10 AND 20
 	if c.Opt == Between || c.Opt == NotBetween {
-		return fmt.Sprintf("%s %s %v", c.Field, c.opt(), c.Value)
+		if valueBetween, ok := c.Value.(ValueBetween); ok {
+			return fmt.Sprintf("%s %s %s", dialectField(d, c.Field), c.opt(), valueBetween.render(d))
+		}
+
+		return fmt.Sprintf("%s %s %v", dialectField(d, c.Field), c.opt(), c.Value)
 	}
 
 	// WHERE salary = (SELECT DISTINCT salary FROM employees ORDER BY salary DESC LIMIT 1 , 1);
@@ -283,18 +312,36 @@ func (c *Condition) String() string {
 	// WHERE ProductID = ANY (SELECT ProductID FROM OrderDetails WHERE Quantity = 10);
 	// WHERE ProductID > ALL (SELECT ProductID FROM OrderDetails WHERE Quantity = 10);
 	if valueQueryBuilder, ok := c.Value.(*QueryBuilder); ok { // Column type is a complex query.
-		return fmt.Sprintf("%s %s (%v)", c.Field, c.opt(), valueQueryBuilder)
+		return fmt.Sprintf("%s %s (%v)", dialectField(d, c.Field), c.opt(), valueQueryBuilder.render(d))
+	}
+
+	// WHERE id IN (SELECT id FROM customers UNION SELECT id FROM suppliers);
+	if valueCompound, ok := c.Value.(*CompoundBuilder); ok { // Column type is a compound query.
+		return fmt.Sprintf("%s %s (%v)", dialectField(d, c.Field), c.opt(), valueCompound.render(d))
+	}
+
+	// Handle a named parameter of an IN or NOT IN list, an array on PostgreSQL.
+	// Example: WHERE id = ANY(:ids)
+	if param, ok := c.Value.(Param); ok && (c.Opt == In || c.Opt == NotIn) && isDialect(d, PostgreSQL) {
+		return c.anyArray(dialectField(d, c.Field), param.render(d))
+	}
+
+	// Handle expression values.
+	// Example: WHERE total > price * 1.2
+	if valueExpr, ok := c.Value.(Expr); ok {
+		return fmt.Sprintf("%s %s %s", dialectField(d, c.Field), c.opt(), valueExpr.render(d))
 	}
 
-	// Handle string value conditions.
-	// Example: WHERE Name = 'John'
-	if valueString, ok := c.Value.(string); ok {
-		return fmt.Sprintf("%s %s '%v'", c.Field, c.opt(), valueString)
+	// Handle ValueField values, written as is.
+	// Example: WHERE e.department_id = d.department_id
+	if valueField, ok := c.Value.(IValueField); ok {
+		return fmt.Sprintf("%s %s %s", dialectField(d, c.Field), c.opt(), valueField.Value())
 	}
 
-	// Default case: Handle simple field-value conditions.
+	// Default case: Handle simple field-value conditions with the literal of the value.
+	// Example: WHERE Name = 'O''Brien'
 	// Example: WHERE Age > 30
-	return fmt.Sprintf("%s %s %v", c.Field, c.opt(), c.Value)
+	return fmt.Sprintf("%s %s %s", dialectField(d, c.Field), c.opt(), inline(d, c.Value))
 }
 
 type WhereAndOr int
@@ -317,21 +364,20 @@ type ValueBetween struct {
 //
 // Returns:
 //   - string: A string representing the range in the format "Low AND High"
-//     If the bounds are strings, they are enclosed in single quotes.
+//     The bounds are written as literals, strings are quoted and escaped.
 //
 // Examples:
 //   - If Low = 1999 and High = 2000, it returns "1999 AND 2000"
 //   - If Low = "1999-01-01" and High = "2000-12-31", it returns "'1999-01-01' AND '2000-12-31'"
 func (v ValueBetween) String() string {
-	if _, ok := v.Low.(string); ok {
-		// If bounds are strings, include single quotes around them.
-		// Eg: hire_date BETWEEN '1999-01-01' AND '2000-12-31'
-		return fmt.Sprintf("'%v' AND '%v'", v.Low, v.High)
-	}
+	return v.render(nil)
+}
 
-	// Return the range as is for non-string bounds.
+// render generates the SQL of String with the dialect d, the default dialect when nil.
+func (v ValueBetween) render(d Dialect) string {
+	// Eg: hire_date BETWEEN '1999-01-01' AND '2000-12-31'
 	// Eg: salary NOT BETWEEN 2500 AND 2900
-	return fmt.Sprintf("%v AND %v", v.Low, v.High)
+	return fmt.Sprintf("%s AND %s", inline(d, v.Low), inline(d, v.High))
 }
 
 // ValueField represents a column/field in a SQL query as a string value.
@@ -434,5 +480,129 @@ type FieldYear string
 //   - To use as part of a WHERE clause or SELECT statement.
 //   - The generated output varies depending on the database type (MySQL, PostgreSQL, SQLite).
 func (v FieldYear) String() string {
-	return DefaultDialect().YearFunction(string(v))
+	return v.render(nil)
+}
+
+// render generates the SQL of String with the dialect d, the default dialect when nil.
+func (v FieldYear) render(d Dialect) string {
+	return dialectOr(d).YearFunction(string(v))
+}
+
+// inValues returns the values of an IN or NOT IN list.
+//
+// Parameters:
+//   - value: The value of the condition, any slice or array, nil for an empty list.
+//
+// Returns:
+//   - []any: The values of the list.
+//   - bool: false when the value is not a list, e.g. a subquery.
+func inValues(value any) ([]any, bool) {
+	if value == nil {
+		return nil, true
+	}
+
+	list := reflect.ValueOf(value)
+	if list.Kind() != reflect.Slice && list.Kind() != reflect.Array {
+		return nil, false
+	}
+
+	values := make([]any, list.Len())
+	for i := range values {
+		values[i] = list.Index(i).Interface()
+	}
+
+	return values, true
+}
+
+// arrayIn checks if the dialect d, the default dialect when nil, binds IN lists as one array parameter.
+func arrayIn(d Dialect) bool {
+	d = dialectOr(d)
+	if strict, ok := d.(strictDialect); ok {
+		d = strict.Dialect
+	}
+
+	switch postgres := d.(type) {
+	case PostgreSQLDialect:
+		return postgres.ArrayIn
+	case *PostgreSQLDialect:
+		return postgres.ArrayIn
+	}
+
+	return false
+}
+
+// emptyIn generates the predicate of an IN or NOT IN condition with an empty list, which is invalid SQL
+// as "col IN ()". An empty IN list matches no row and an empty NOT IN list matches every row,
+// so they are written with predicates valid in every dialect.
+//
+// Returns:
+//   - string: "1 = 0" for IN, "1 = 1" for NOT IN.
+func (c *Condition) emptyIn() string {
+	if c.Opt == NotIn {
+		return "1 = 1"
+	}
+
+	return "1 = 0"
+}
+
+// anyArray generates an IN or NOT IN condition comparing the field with the elements of an array.
+//
+// Parameters:
+//   - field: The rendered field.
+//   - array: The array, e.g. a placeholder.
+//
+// Returns:
+//   - string: E.g. "id = ANY($1)" for IN or "id <> ALL($1)" for NOT IN.
+func (c *Condition) anyArray(field any, array string) string {
+	if c.Opt == NotIn {
+		return fmt.Sprintf("%s <> ALL(%s)", field, array)
+	}
+
+	return fmt.Sprintf("%s = ANY(%s)", field, array)
+}
+
+// dialectField returns a field of a condition or a column of a statement ready to be formatted,
+// rendering FieldYear and expressions, such as Ident, with the dialect d instead of the default dialect.
+//
+// Parameters:
+//   - d: The dialect of the builder, the default dialect when nil.
+//   - field: The field, e.g. a string, FieldNot, FieldYear or Expr.
+//
+// Returns:
+//   - any: The field, formatted with %s or %v by the caller.
+func dialectField(d Dialect, field any) any {
+	if fieldYear, ok := field.(FieldYear); ok {
+		return fieldYear.render(d)
+	}
+
+	if fieldExpr, ok := field.(Expr); ok {
+		return fieldExpr.render(d)
+	}
+
+	return field
+}
+
+// dialectFieldArgs returns a field of a condition or a column of a statement ready to be formatted,
+// appending the parameters of an expression to the arguments slice.
+//
+// Parameters:
+//   - d: The dialect of the builder, the default dialect when nil.
+//   - field: The field, e.g. a string, FieldNot, FieldYear or Expr.
+//   - args []any: A slice of arguments.
+//
+// Returns:
+//   - any: The field, formatted with %s or %v by the caller.
+//   - []any: The updated slice of arguments.
+//   - error: The error of an expression.
+func dialectFieldArgs(d Dialect, field any, args []any) (any, []any, error) {
+	// FieldYear binds its column as a parameter in StringArgs, a field is never bound.
+	if _, ok := field.(FieldYear); ok {
+		return dialectField(d, field), args, nil
+	}
+
+	if fieldExpr, ok := field.(Expr); ok {
+		return fieldExpr.renderArgs(d, args)
+	}
+
+	return dialectField(d, field), args, nil
 }
@@ -2,7 +2,8 @@ package fluentsql
 
 // WhereBuilder struct
 type WhereBuilder struct {
-	whereStatement Where // whereStatement holds the WHERE conditions of the query.
+	dialect        Dialect // dialect overrides the default dialect when set.
+	whereStatement Where   // whereStatement holds the WHERE conditions of the query.
 }
 
 // WhereInstance Query builder constructor
@@ -102,7 +103,7 @@ type FnWhereBuilder func(whereBuilder WhereBuilder) *WhereBuilder
 // Returns:
 //   - string: The string representation of the WHERE clause.
 func (wb *WhereBuilder) String() string {
-	return wb.whereStatement.String()
+	return wb.whereStatement.render(wb.dialect)
 }
 
 // StringArgs constructs the WHERE clause as a string with argument placeholders.
@@ -114,7 +115,36 @@ func (wb *WhereBuilder) String() string {
 //   - string: The string representation of the WHERE clause with placeholders.
 //   - []any: The updated slice of arguments.
 func (wb *WhereBuilder) StringArgs(args []any) (string, []any) {
-	return wb.whereStatement.StringArgs(args)
+	sql, args, _ := wb.whereStatement.renderArgs(wb.dialect, args)
+
+	return sql, args
+}
+
+// Interpolate constructs the WHERE clause with its arguments inlined as literals escaped for the dialect.
+//
+// Returns:
+//   - string: The WHERE clause without placeholders.
+//   - error: The error of a nested query or expression, or an error when an argument cannot be written as a literal.
+func (wb *WhereBuilder) Interpolate() (string, error) {
+	sql, args, err := wb.whereStatement.renderArgs(wb.dialect, nil)
+	if err != nil {
+		return "", err
+	}
+
+	return interpolate(wb.dialect, sql, args)
+}
+
+// SetDialect sets the dialect used to generate the WHERE clause instead of the default dialect.
+//
+// Parameters:
+//   - dialect (Dialect): The database dialect, e.g. new(MySQLDialect).
+//
+// Returns:
+//   - *WhereBuilder: The current instance of WhereBuilder for chaining.
+func (wb *WhereBuilder) SetDialect(dialect Dialect) *WhereBuilder {
+	wb.dialect = dialect
+
+	return wb
 }
 
 // Conditions retrieves all conditions of the WHERE clause.
@@ -1,6 +1,7 @@
 package fluentsql
 
 import (
+	"fmt"
 	"testing"
 )
 
@@ -112,6 +113,31 @@ func TestWhereIn(t *testing.T) {
 			Opt:   NotIn,
 			Value: []int{7, 8, 9},
 		},
+		"WHERE job_id IN (7, 8)": {
+			Field: "job_id",
+			Opt:   In,
+			Value: []int64{7, 8},
+		},
+		"WHERE job_id IN ('a', 8)": {
+			Field: "job_id",
+			Opt:   In,
+			Value: []any{"a", 8},
+		},
+		"WHERE job_id IN (1, 2)": {
+			Field: "job_id",
+			Opt:   In,
+			Value: [2]uint{1, 2},
+		},
+		"WHERE 1 = 0": {
+			Field: "job_id",
+			Opt:   In,
+			Value: []string{},
+		},
+		"WHERE 1 = 1": {
+			Field: "job_id",
+			Opt:   NotIn,
+			Value: nil,
+		},
 	}
 
 	for expected, condition := range testCases {
@@ -273,3 +299,47 @@ func TestWhereOthers(t *testing.T) {
 		}
 	}
 }
+
+// TestWhereInArgs
+func TestWhereInArgs(t *testing.T) {
+	type id int64
+
+	sql, args, _ := (&Condition{Field: "id", Opt: In, Value: []id{1, 2, 3}}).renderArgs(new(MySQLDialect), nil)
+	if sql != "id IN (?, ?, ?)" || len(args) != 3 || args[2] != id(3) {
+		t.Fatalf("Unexpected query %s %v", sql, args)
+	}
+
+	sql, args, _ = (&Condition{Field: "id", Opt: In, Value: []int(nil)}).renderArgs(new(MySQLDialect), nil)
+	if sql != "1 = 0" || len(args) != 0 {
+		t.Fatalf("Unexpected query %s %v", sql, args)
+	}
+
+	// The parameters of the field of an empty list are not bound
+	sql, args, err := QueryInstance().
+		SetDialect(new(PostgreSQLDialect)).
+		From("users").
+		Where(Func("LOWER", Arg("X")), In, []string{}).
+		Where("b", Eq, 2).
+		Sql()
+	if err != nil || sql != "SELECT * FROM users WHERE 1 = 0 AND b = $1" || fmt.Sprint(args) != "[2]" {
+		t.Fatalf("Unexpected query %s %v (%v)", sql, args, err)
+	}
+
+	// PostgreSQL binds the list as one array parameter
+	postgres := &PostgreSQLDialect{ArrayIn: true}
+
+	sql, args, _ = (&Condition{Field: "id", Opt: In, Value: []int{1, 2, 3}}).renderArgs(postgres, nil)
+	if sql != "id = ANY($1)" || len(args) != 1 {
+		t.Fatalf("Unexpected query %s %v", sql, args)
+	}
+
+	sql, args, _ = (&Condition{Field: "id", Opt: NotIn, Value: []string{"a"}}).renderArgs(Strict(postgres), nil)
+	if sql != "id <> ALL($1)" || len(args) != 1 {
+		t.Fatalf("Unexpected query %s %v", sql, args)
+	}
+
+	sql, err = QueryInstance().SetDialect(postgres).From("users").Where("id", In, []int{1, 2}).Interpolate()
+	if err != nil || sql != "SELECT * FROM users WHERE id = ANY(ARRAY[1, 2])" {
+		t.Fatalf("Unexpected query %s (%v)", sql, err)
+	}
+}
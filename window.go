@@ -0,0 +1,483 @@
+package fluentsql
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FrameUnit represents the unit of a window frame.
+//
+// Values:
+// - FrameRows: The frame is defined by physical rows (ROWS).
+// - FrameRange: The frame is defined by a value range (RANGE).
+// - FrameGroups: The frame is defined by peer groups (GROUPS).
+type FrameUnit int
+
+const (
+	FrameRows   FrameUnit = iota // ROWS
+	FrameRange                   // RANGE
+	FrameGroups                  // GROUPS
+)
+
+// FrameBound represents the start or end boundary of a window frame.
+type FrameBound string
+
+const (
+	UnboundedPreceding FrameBound = "UNBOUNDED PRECEDING" // First row of the partition
+	CurrentRow         FrameBound = "CURRENT ROW"         // The current row
+	UnboundedFollowing FrameBound = "UNBOUNDED FOLLOWING" // Last row of the partition
+)
+
+// Preceding creates a frame boundary located n rows (or values, groups) before the current row.
+//
+// Parameters:
+//   - n: The offset from the current row.
+//
+// Returns:
+//   - FrameBound: E.g. "3 PRECEDING".
+func Preceding(n int) FrameBound {
+	return FrameBound(fmt.Sprintf("%d PRECEDING", n))
+}
+
+// Following creates a frame boundary located n rows (or values, groups) after the current row.
+//
+// Parameters:
+//   - n: The offset from the current row.
+//
+// Returns:
+//   - FrameBound: E.g. "3 FOLLOWING".
+func Following(n int) FrameBound {
+	return FrameBound(fmt.Sprintf("%d FOLLOWING", n))
+}
+
+// WindowFrame represents the frame clause of a window specification.
+//
+// Fields:
+//   - Unit: The frame unit (ROWS, RANGE, GROUPS).
+//   - Start: The start boundary of the frame.
+//   - End: The optional end boundary. When empty, only the start boundary is rendered.
+type WindowFrame struct {
+	Unit  FrameUnit
+	Start FrameBound
+	End   FrameBound
+}
+
+// unit returns the SQL keyword of the frame unit.
+//
+// Returns:
+//   - string: "ROWS", "RANGE" or "GROUPS".
+func (f *WindowFrame) unit() string {
+	var sign string
+
+	switch f.Unit {
+	case FrameRows:
+		sign = "ROWS"
+	case FrameRange:
+		sign = "RANGE"
+	case FrameGroups:
+		sign = "GROUPS"
+	}
+
+	return sign
+}
+
+// String generates the SQL representation of the window frame.
+//
+// Returns:
+//   - string: E.g. "ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW". Returns an empty string if no start boundary is set.
+func (f *WindowFrame) String() string {
+	if f.Start == "" {
+		return ""
+	}
+
+	if f.End == "" {
+		return fmt.Sprintf("%s %s", f.unit(), f.Start)
+	}
+
+	return fmt.Sprintf("%s BETWEEN %s AND %s", f.unit(), f.Start, f.End)
+}
+
+// WindowSpec represents a window specification used by OVER (...) and WINDOW name AS (...).
+//
+// Fields:
+//   - Base: Optional name of an existing window this specification is based on.
+//   - Partition: The PARTITION BY expressions.
+//   - Order: The ORDER BY clause of the window.
+//   - Frame: The frame clause of the window.
+type WindowSpec struct {
+	Base      string
+	Partition []string
+	Order     OrderBy
+	Frame     WindowFrame
+}
+
+// WindowInstance creates a new window specification.
+//
+// Parameters:
+//   - base: Optional name of an existing window the specification is based on.
+//
+// Returns:
+//   - *WindowSpec: A pointer to a new WindowSpec instance.
+func WindowInstance(base ...string) *WindowSpec {
+	spec := &WindowSpec{}
+
+	if len(base) > 0 {
+		spec.Base = base[0]
+	}
+
+	return spec
+}
+
+// PartitionBy appends expressions to the PARTITION BY list of the window.
+//
+// Parameters:
+//   - fields: The expressions to partition by.
+//
+// Returns:
+//   - *WindowSpec: The current WindowSpec instance.
+func (w *WindowSpec) PartitionBy(fields ...string) *WindowSpec {
+	w.Partition = append(w.Partition, fields...)
+
+	return w
+}
+
+// OrderBy appends a sort item to the ORDER BY clause of the window.
+//
+// Parameters:
+//   - field: The field to sort by.
+//   - dir: The direction of sorting (Asc or Desc).
+//
+// Returns:
+//   - *WindowSpec: The current WindowSpec instance.
+func (w *WindowSpec) OrderBy(field string, dir OrderByDir) *WindowSpec {
+	w.Order.Append(field, dir)
+
+	return w
+}
+
+// OrderBySort appends sort items with a NULLS position or a collation to the ORDER BY clause of the window.
+// MySQL and SQL Server emulate the NULLS position as the ORDER BY clause of the query does.
+//
+// Parameters:
+//   - items: The sort items, e.g. Sort("hire_date", Asc).NullsLast().
+//
+// Returns:
+//   - *WindowSpec: The current WindowSpec instance.
+func (w *WindowSpec) OrderBySort(items ...SortItem) *WindowSpec {
+	w.Order.Items = append(w.Order.Items, items...)
+
+	return w
+}
+
+// Rows sets a ROWS frame on the window.
+//
+// Parameters:
+//   - start: The start boundary of the frame.
+//   - end: Optional end boundary of the frame.
+//
+// Returns:
+//   - *WindowSpec: The current WindowSpec instance.
+func (w *WindowSpec) Rows(start FrameBound, end ...FrameBound) *WindowSpec {
+	return w.frame(FrameRows, start, end...)
+}
+
+// Range sets a RANGE frame on the window.
+//
+// Parameters:
+//   - start: The start boundary of the frame.
+//   - end: Optional end boundary of the frame.
+//
+// Returns:
+//   - *WindowSpec: The current WindowSpec instance.
+func (w *WindowSpec) Range(start FrameBound, end ...FrameBound) *WindowSpec {
+	return w.frame(FrameRange, start, end...)
+}
+
+// Groups sets a GROUPS frame on the window.
+//
+// Parameters:
+//   - start: The start boundary of the frame.
+//   - end: Optional end boundary of the frame.
+//
+// Returns:
+//   - *WindowSpec: The current WindowSpec instance.
+func (w *WindowSpec) Groups(start FrameBound, end ...FrameBound) *WindowSpec {
+	return w.frame(FrameGroups, start, end...)
+}
+
+// frame sets the frame clause of the window.
+//
+// Parameters:
+//   - unit: The frame unit.
+//   - start: The start boundary of the frame.
+//   - end: Optional end boundary of the frame.
+//
+// Returns:
+//   - *WindowSpec: The current WindowSpec instance.
+func (w *WindowSpec) frame(unit FrameUnit, start FrameBound, end ...FrameBound) *WindowSpec {
+	w.Frame = WindowFrame{
+		Unit:  unit,
+		Start: start,
+	}
+
+	if len(end) > 0 {
+		w.Frame.End = end[0]
+	}
+
+	return w
+}
+
+// isReference reports whether the specification only refers to a named window,
+// in which case it is rendered as `OVER name` without parentheses.
+//
+// Returns:
+//   - bool: true if only the base window is set.
+func (w *WindowSpec) isReference() bool {
+	return w.Base != "" && len(w.Partition) == 0 && len(w.Order.Items) == 0 && w.Frame.Start == ""
+}
+
+// String generates the SQL representation of the window specification without the enclosing parentheses.
+//
+// Returns:
+//   - string: E.g. "PARTITION BY department_id ORDER BY salary DESC ROWS UNBOUNDED PRECEDING".
+func (w *WindowSpec) String() string {
+	return w.render(nil)
+}
+
+// render generates the SQL of String with the dialect d, the default dialect when nil.
+func (w *WindowSpec) render(d Dialect) string {
+	var parts []string
+
+	if w.Base != "" {
+		parts = append(parts, w.Base)
+	}
+
+	if len(w.Partition) > 0 {
+		parts = append(parts, fmt.Sprintf("PARTITION BY %s", strings.Join(w.Partition, ", ")))
+	}
+
+	orderBySql := w.Order.render(d)
+	if orderBySql != "" {
+		parts = append(parts, orderBySql)
+	}
+
+	frameSql := w.Frame.String()
+	if frameSql != "" {
+		parts = append(parts, frameSql)
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// WindowFunction represents a window function call used as a select column.
+//
+// Fields:
+//   - Function: The function name (e.g. ROW_NUMBER, LAG, SUM).
+//   - Field: The column or expression the function is applied to. Empty for functions without one.
+//   - Args: Additional arguments (e.g. the LAG offset and default value), bound as parameters.
+//   - Window: The window specification of the OVER clause.
+//   - Name: The alias of the column.
+//
+// Examples:
+//
+//	ROW_NUMBER() OVER (PARTITION BY department_id ORDER BY salary DESC) AS rn
+//	LAG(salary, 1, 0) OVER w AS previous_salary
+type WindowFunction struct {
+	Function string
+	Field    string
+	Args     []any
+	Window   *WindowSpec
+	Name     string
+}
+
+// FieldWindow creates a window function call.
+//
+// Parameters:
+//   - function: The function name (e.g. "SUM", "AVG", "FIRST_VALUE").
+//   - field: The column or expression the function is applied to. Can be empty.
+//   - args: Additional arguments bound as parameters.
+//
+// Returns:
+//   - *WindowFunction: A pointer to a new WindowFunction instance.
+func FieldWindow(function, field string, args ...any) *WindowFunction {
+	return &WindowFunction{
+		Function: function,
+		Field:    field,
+		Args:     args,
+	}
+}
+
+// RowNumber creates a ROW_NUMBER() window function.
+func RowNumber() *WindowFunction {
+	return FieldWindow("ROW_NUMBER", "")
+}
+
+// Rank creates a RANK() window function.
+func Rank() *WindowFunction {
+	return FieldWindow("RANK", "")
+}
+
+// DenseRank creates a DENSE_RANK() window function.
+func DenseRank() *WindowFunction {
+	return FieldWindow("DENSE_RANK", "")
+}
+
+// Ntile creates a NTILE(buckets) window function. The number of buckets is bound as a parameter.
+func Ntile(buckets int) *WindowFunction {
+	return FieldWindow("NTILE", "", buckets)
+}
+
+// Lag creates a LAG(field [, offset [, default]]) window function.
+// The optional offset and default value are bound as parameters.
+func Lag(field string, offsetDefault ...any) *WindowFunction {
+	return FieldWindow("LAG", field, offsetDefault...)
+}
+
+// Lead creates a LEAD(field [, offset [, default]]) window function.
+// The optional offset and default value are bound as parameters.
+func Lead(field string, offsetDefault ...any) *WindowFunction {
+	return FieldWindow("LEAD", field, offsetDefault...)
+}
+
+// Over sets the window specification of the OVER clause.
+//
+// Parameters:
+//   - spec: The window specification.
+//
+// Returns:
+//   - *WindowFunction: The current WindowFunction instance.
+func (f *WindowFunction) Over(spec *WindowSpec) *WindowFunction {
+	f.Window = spec
+
+	return f
+}
+
+// OverWindow refers to a named window declared with QueryBuilder.Window.
+//
+// Parameters:
+//   - name: The name of the window.
+//
+// Returns:
+//   - *WindowFunction: The current WindowFunction instance.
+func (f *WindowFunction) OverWindow(name string) *WindowFunction {
+	f.Window = WindowInstance(name)
+
+	return f
+}
+
+// AS sets the alias of the window function column.
+//
+// Parameters:
+//   - alias: The alias to be used.
+//
+// Returns:
+//   - *WindowFunction: The current WindowFunction instance.
+func (f *WindowFunction) AS(alias string) *WindowFunction {
+	f.Name = alias
+
+	return f
+}
+
+// over generates the OVER clause of the window function.
+//
+// Parameters:
+//   - d: The dialect of the builder, the default dialect when nil.
+//
+// Returns:
+//   - string: "OVER name" for a named window reference, otherwise "OVER (spec)".
+func (f *WindowFunction) over(d Dialect) string {
+	if f.Window == nil {
+		return "OVER ()"
+	}
+
+	if f.Window.isReference() {
+		return fmt.Sprintf("OVER %s", f.Window.Base)
+	}
+
+	return fmt.Sprintf("OVER (%s)", f.Window.render(d))
+}
+
+// alias generates the alias suffix of the window function column.
+//
+// Returns:
+//   - string: " AS name" or an empty string.
+func (f *WindowFunction) alias() string {
+	if f.Name == "" {
+		return ""
+	}
+
+	return " AS " + f.Name
+}
+
+// String generates the SQL representation of the window function column.
+//
+// Returns:
+//   - string: E.g. "LAG(salary, 1, 0) OVER (ORDER BY hire_date ASC) AS previous_salary".
+func (f *WindowFunction) String() string {
+	return f.render(nil)
+}
+
+// render generates the SQL of String with the dialect d, the default dialect when nil.
+func (f *WindowFunction) render(d Dialect) string {
+	var arguments []string
+
+	if f.Field != "" {
+		arguments = append(arguments, f.Field)
+	}
+
+	for _, arg := range f.Args {
+		arguments = append(arguments, inline(d, arg))
+	}
+
+	return fmt.Sprintf("%s(%s) %s%s", f.Function, strings.Join(arguments, ", "), f.over(d), f.alias())
+}
+
+// WindowItem represents a named window declared in the WINDOW clause.
+//
+// Fields:
+//   - Name: The name of the window.
+//   - Spec: The window specification.
+type WindowItem struct {
+	Name string
+	Spec WindowSpec
+}
+
+// Window clause
+type Window struct {
+	// Items holds the named windows of the WINDOW clause.
+	Items []WindowItem
+}
+
+// Append adds a named window to the WINDOW clause.
+//
+// Parameters:
+//   - name: The name of the window.
+//   - spec: The window specification.
+func (w *Window) Append(name string, spec WindowSpec) {
+	w.Items = append(w.Items, WindowItem{
+		Name: name,
+		Spec: spec,
+	})
+}
+
+// String generates the SQL WINDOW clause.
+//
+// Returns:
+//   - string: E.g. "WINDOW w AS (PARTITION BY department_id)". Returns an empty string if no windows are declared.
+func (w *Window) String() string {
+	return w.render(nil)
+}
+
+// render generates the SQL of String with the dialect d, the default dialect when nil.
+func (w *Window) render(d Dialect) string {
+	if len(w.Items) == 0 {
+		return ""
+	}
+
+	var items []string
+	for _, item := range w.Items {
+		items = append(items, fmt.Sprintf("%s AS (%s)", item.Name, item.Spec.render(d)))
+	}
+
+	return fmt.Sprintf("WINDOW %s", strings.Join(items, ", "))
+}
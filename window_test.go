@@ -0,0 +1,132 @@
+package fluentsql
+
+import (
+	"testing"
+)
+
+// TestWindowFunction
+func TestWindowFunction(t *testing.T) {
+	testCases := map[string]*WindowFunction{
+		"ROW_NUMBER() OVER ()": RowNumber(),
+		"RANK() OVER (PARTITION BY department_id ORDER BY salary DESC) AS salary_rank": Rank().
+			Over(WindowInstance().PartitionBy("department_id").OrderBy("salary", Desc)).
+			AS("salary_rank"),
+		"DENSE_RANK() OVER w": DenseRank().OverWindow("w"),
+		"NTILE(4) OVER (ORDER BY salary ASC) AS quartile": Ntile(4).
+			Over(WindowInstance().OrderBy("salary", Asc)).
+			AS("quartile"),
+		"LAG(salary, 1, 0) OVER (w ORDER BY hire_date ASC) AS previous_salary": Lag("salary", 1, 0).
+			Over(WindowInstance("w").OrderBy("hire_date", Asc)).
+			AS("previous_salary"),
+		"LEAD(status, 1, 'none') OVER (ORDER BY created_at ASC)": Lead("status", 1, "none").
+			Over(WindowInstance().OrderBy("created_at", Asc)),
+		"SUM(amount) OVER (PARTITION BY account_id ORDER BY created_at ASC ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW) AS balance": FieldWindow("SUM", "amount").
+			Over(WindowInstance().PartitionBy("account_id").OrderBy("created_at", Asc).Rows(UnboundedPreceding, CurrentRow)).
+			AS("balance"),
+		"AVG(price) OVER (ORDER BY day ASC RANGE BETWEEN 3 PRECEDING AND 3 FOLLOWING)": FieldWindow("AVG", "price").
+			Over(WindowInstance().OrderBy("day", Asc).Range(Preceding(3), Following(3))),
+		"COUNT(*) OVER (ORDER BY score ASC GROUPS UNBOUNDED PRECEDING)": FieldWindow("COUNT", "*").
+			Over(WindowInstance().OrderBy("score", Asc).Groups(UnboundedPreceding)),
+	}
+
+	for expected, window := range testCases {
+		if window.String() != expected {
+			t.Fatalf(`Query %s != %s`, window.String(), expected)
+		}
+	}
+}
+
+// TestWindowFunctionStringArgs
+func TestWindowFunctionStringArgs(t *testing.T) {
+	window := Lag("salary", 2, 0).
+		Over(WindowInstance().PartitionBy("department_id").OrderBy("hire_date", Asc)).
+		AS("previous_salary")
+
+	args := []any{"first"}
+	sql, args := window.StringArgs(args)
+
+	expected := "LAG(salary, $2, $3) OVER (PARTITION BY department_id ORDER BY hire_date ASC) AS previous_salary"
+	if sql != expected {
+		t.Fatalf(`Query %s != %s`, sql, expected)
+	}
+
+	if len(args) != 3 || args[1] != 2 || args[2] != 0 {
+		t.Fatalf("Unexpected arguments %v", args)
+	}
+}
+
+// TestWindowClause
+func TestWindowClause(t *testing.T) {
+	windowTest := new(Window)
+
+	windowTest.Append("w", *WindowInstance().PartitionBy("department_id").OrderBy("salary", Desc))
+	windowTest.Append("w2", *WindowInstance("w").Rows(Preceding(1), Following(1)))
+	expected := "WINDOW w AS (PARTITION BY department_id ORDER BY salary DESC), w2 AS (w ROWS BETWEEN 1 PRECEDING AND 1 FOLLOWING)"
+
+	if windowTest.String() != expected {
+		t.Fatalf(`Query %s != %s`, windowTest.String(), expected)
+	}
+
+	sql, _ := windowTest.StringArgs(nil)
+	if sql != expected {
+		t.Fatalf(`Query %s != %s`, sql, expected)
+	}
+
+	if sql, _ = new(Window).StringArgs(nil); sql != "" {
+		t.Fatalf("Expected empty WINDOW clause, got %s", sql)
+	}
+}
+
+// TestWindowDialect tests the window functions and the WINDOW clause with the dialect of the query
+func TestWindowDialect(t *testing.T) {
+	spec := WindowInstance().PartitionBy("department_id")
+	spec.Order.Append(Ident("hire_date"), Asc)
+
+	query := QueryInstance().
+		SetDialect(new(MySQLDialect)).
+		Select("id", RowNumber().Over(spec).AS("rn"), Lead("status", 1, true).OverWindow("w")).
+		From("employees").
+		Window("w", spec)
+
+	expected := "SELECT id, ROW_NUMBER() OVER (PARTITION BY department_id ORDER BY `hire_date` ASC) AS rn, " +
+		"LEAD(status, ?, ?) OVER w FROM employees WINDOW w AS (PARTITION BY department_id ORDER BY `hire_date` ASC)"
+
+	sql, _, err := query.Sql()
+	if err != nil || sql != expected {
+		t.Fatalf(`Query %s != %s (%v)`, sql, expected, err)
+	}
+
+	expected = "SELECT id, ROW_NUMBER() OVER (PARTITION BY department_id ORDER BY `hire_date` ASC) AS rn, " +
+		"LEAD(status, 1, true) OVER w FROM employees WINDOW w AS (PARTITION BY department_id ORDER BY `hire_date` ASC)"
+	if query.String() != expected {
+		t.Fatalf(`Query %s != %s`, query.String(), expected)
+	}
+}
+
+// TestWindowNulls tests the NULLS position of the window ORDER BY with each dialect
+func TestWindowNulls(t *testing.T) {
+	testCases := []struct {
+		dialect  Dialect
+		expected string
+	}{
+		{new(PostgreSQLDialect), "SELECT RANK() OVER (ORDER BY hire_date ASC NULLS LAST) FROM employees"},
+		{new(MySQLDialect), "SELECT RANK() OVER (ORDER BY hire_date IS NULL ASC, hire_date ASC) FROM employees"},
+		{new(SQLServerDialect), "SELECT RANK() OVER (ORDER BY CASE WHEN hire_date IS NULL THEN 1 ELSE 0 END ASC, hire_date ASC) FROM employees"},
+	}
+
+	for _, testCase := range testCases {
+		query := QueryInstance().
+			SetDialect(testCase.dialect).
+			Select(Rank().Over(WindowInstance().OrderBySort(Sort("hire_date", Asc).NullsLast()))).
+			From("employees")
+
+		sql, _, err := query.Sql()
+		if err != nil || sql != testCase.expected {
+			t.Fatalf(`Query %s != %s (%v)`, sql, testCase.expected, err)
+		}
+
+		if query.String() != testCase.expected {
+			t.Fatalf(`Query %s != %s`, query.String(), testCase.expected)
+		}
+	}
+}
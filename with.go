@@ -0,0 +1,162 @@
+package fluentsql
+
+import (
+	"fmt"
+	"strings"
+)
+
+// CTEMaterialized represents the materialization hint of a common table expression.
+//
+// Values:
+// - MaterializedDefault: No hint, the database decides.
+// - Materialized: Force the CTE to be computed once (AS MATERIALIZED).
+// - NotMaterialized: Allow the CTE to be inlined into the main query (AS NOT MATERIALIZED).
+type CTEMaterialized int
+
+const (
+	MaterializedDefault CTEMaterialized = iota // No materialization hint.
+	Materialized                               // AS MATERIALIZED
+	NotMaterialized                            // AS NOT MATERIALIZED
+)
+
+// CTE represents a single common table expression in a WITH clause.
+//
+// Fields:
+//   - Name: The name the CTE is referenced by in the main statement.
+//   - Columns: Optional list of column names for the CTE.
+//   - Query: The body of the CTE, a *QueryBuilder or a *CompoundBuilder (e.g. UNION ALL for recursive CTEs).
+//   - Materialized: Optional materialization hint (PostgreSQL and SQLite), dropped under other dialects.
+type CTE struct {
+	Name         string
+	Columns      []string
+	Query        any
+	Materialized CTEMaterialized
+}
+
+// hint returns the materialization hint of the CTE as a SQL keyword.
+//
+// Parameters:
+//   - d: The dialect of the builder, only PostgreSQL and SQLite know the hint.
+//
+// Returns:
+//   - string: "MATERIALIZED ", "NOT MATERIALIZED " or an empty string.
+func (c *CTE) hint(d Dialect) string {
+	var sign string
+
+	if !isDialect(d, PostgreSQL) && !isDialect(d, SQLite) {
+		return sign
+	}
+
+	switch c.Materialized {
+	case Materialized:
+		sign = "MATERIALIZED "
+	case NotMaterialized:
+		sign = "NOT MATERIALIZED "
+	}
+
+	return sign
+}
+
+// head generates the part of the CTE before its body.
+//
+// Parameters:
+//   - d: The dialect of the builder.
+//
+// Returns:
+//   - string: The CTE name, its optional column list and the AS keyword with an optional hint.
+//     E.g. `tree (id, parent_id) AS MATERIALIZED`
+func (c *CTE) head(d Dialect) string {
+	var sb strings.Builder
+
+	sb.WriteString(c.Name)
+
+	if len(c.Columns) > 0 {
+		sb.WriteString(fmt.Sprintf(" (%s)", strings.Join(c.Columns, ", ")))
+	}
+
+	sb.WriteString(" AS " + c.hint(d))
+
+	return sb.String()
+}
+
+// String generates the SQL representation of a single CTE.
+//
+// Returns:
+//   - string: The CTE in the format `name [(columns)] AS [[NOT] MATERIALIZED] (query)`.
+func (c *CTE) String() string {
+	return c.render(nil)
+}
+
+// render generates the SQL of String with the dialect d, the default dialect when nil.
+func (c *CTE) render(d Dialect) string {
+	var body string
+
+	if queryBuilder, ok := c.Query.(*QueryBuilder); ok {
+		body = queryBuilder.render(d)
+	} else if compoundBuilder, ok := c.Query.(*CompoundBuilder); ok {
+		body = compoundBuilder.render(d)
+	}
+
+	return fmt.Sprintf("%s(%s)", c.head(d), body)
+}
+
+// With clause represents the WITH [RECURSIVE] prefix of a statement.
+//
+// Fields:
+//   - Recursive: Add the RECURSIVE modifier, so the CTEs can reference themselves.
+//   - Items: The list of common table expressions.
+type With struct {
+	Recursive bool
+	Items     []CTE
+}
+
+// Append adds one or more CTEs to the WITH clause.
+//
+// Parameters:
+//   - items: One or more CTE instances to be added.
+func (w *With) Append(items ...CTE) {
+	w.Items = append(w.Items, items...)
+}
+
+// keyword returns the WITH keyword including the optional RECURSIVE modifier.
+// SQL Server and Oracle have no RECURSIVE keyword, their CTEs can reference themselves without it.
+//
+// Parameters:
+//   - d: The dialect of the builder.
+//
+// Returns:
+//   - string: "WITH" or "WITH RECURSIVE".
+func (w *With) keyword(d Dialect) string {
+	if w.Recursive && !isDialect(d, SQLServer) && !isDialect(d, Oracle) {
+		return "WITH RECURSIVE"
+	}
+
+	return "WITH"
+}
+
+// String generates the SQL WITH clause.
+//
+// Returns:
+//   - string: The WITH clause. Returns an empty string if no CTEs are defined.
+//
+// Examples:
+//
+//	WITH regional_sales AS (SELECT region, SUM(amount) AS total_sales FROM orders GROUP BY region)
+//	WITH RECURSIVE tree (id, parent_id) AS (SELECT id, parent_id FROM categories WHERE parent_id IS NULL)
+func (w *With) String() string {
+	return w.render(nil)
+}
+
+// render generates the SQL of String with the dialect d, the default dialect when nil.
+func (w *With) render(d Dialect) string {
+	if len(w.Items) == 0 {
+		return ""
+	}
+
+	var items []string
+	for _, item := range w.Items {
+		items = append(items, item.render(d))
+	}
+
+	return fmt.Sprintf("%s %s", w.keyword(d), strings.Join(items, ", "))
+}
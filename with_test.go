@@ -0,0 +1,138 @@
+package fluentsql
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestWith
+func TestWith(t *testing.T) {
+	withTest := new(With)
+
+	withTest.Append(CTE{
+		Name:  "regional_sales",
+		Query: QueryInstance().Select("region", "SUM(amount) AS total_sales").From("orders").GroupBy("region"),
+	})
+	expected := "WITH regional_sales AS (SELECT region, SUM(amount) AS total_sales FROM orders GROUP BY region)"
+
+	if withTest.String() != expected {
+		t.Fatalf(`Query %s != %s`, withTest.String(), expected)
+	}
+}
+
+// TestWithRecursiveColumns
+func TestWithRecursiveColumns(t *testing.T) {
+	withTest := new(With)
+
+	withTest.Recursive = true
+	withTest.Append(CTE{
+		Name:         "tree",
+		Columns:      []string{"id", "parent_id"},
+		Query:        QueryInstance().Select("id", "parent_id").From("categories").Where("parent_id", Null, nil),
+		Materialized: NotMaterialized,
+	})
+	expected := "WITH RECURSIVE tree (id, parent_id) AS NOT MATERIALIZED (SELECT id, parent_id FROM categories WHERE parent_id IS NULL)"
+
+	if withTest.String() != expected {
+		t.Fatalf(`Query %s != %s`, withTest.String(), expected)
+	}
+}
+
+// TestWithStringArgs
+func TestWithStringArgs(t *testing.T) {
+	withTest := new(With)
+
+	withTest.Append(CTE{
+		Name:         "a",
+		Query:        QueryInstance().Select("id").From("users").Where("age", Greater, 18),
+		Materialized: Materialized,
+	}, CTE{
+		Name:  "b",
+		Query: QueryInstance().Select("id").From("orders").Where("total", Greater, 100),
+	})
+
+	var args []any
+	sql, args := withTest.StringArgs(args)
+
+	expected := "WITH a AS MATERIALIZED (SELECT id FROM users WHERE age > $1), b AS (SELECT id FROM orders WHERE total > $2)"
+	if sql != expected {
+		t.Fatalf(`Query %s != %s`, sql, expected)
+	}
+
+	if len(args) != 2 || args[0] != 18 || args[1] != 100 {
+		t.Fatalf("Expected arguments [18 100], got %v", args)
+	}
+
+	// Empty WITH clause
+	withTest = new(With)
+	sql, args = withTest.StringArgs(nil)
+
+	if sql != "" || len(args) != 0 {
+		t.Fatalf("Expected empty WITH clause, got %s (%v)", sql, args)
+	}
+}
+
+// TestWithError
+func TestWithError(t *testing.T) {
+	// The error of a CTE body is returned by the statement, SQLite does not support CUBE
+	totals := QueryInstance().Select("region", "product", "SUM(amount) AS total").From("sales").GroupBy(Cube("region", "product"))
+
+	_, _, err := QueryInstance().
+		SetDialect(new(SQLiteDialect)).
+		With("totals", totals).
+		From("totals").
+		Sql()
+	if !errors.Is(err, ErrNotSupported) {
+		t.Fatalf("Expected ErrNotSupported, got %v", err)
+	}
+
+	_, _, err = UpdateInstance().
+		SetDialect(new(SQLiteDialect)).
+		With("totals", totals).
+		Update("regions").
+		Set("reviewed", true).
+		Where("name", In, QueryInstance().Select("region").From("totals")).
+		Sql()
+	if !errors.Is(err, ErrNotSupported) {
+		t.Fatalf("Expected ErrNotSupported, got %v", err)
+	}
+}
+
+// TestWithDialect tests the RECURSIVE keyword and materialization hints per dialect
+func TestWithDialect(t *testing.T) {
+	query := func(dialect Dialect) *QueryBuilder {
+		return QueryInstance().
+			SetDialect(dialect).
+			WithRecursive("tree", QueryInstance().Select("id").From("categories")).
+			WithMaterialized("totals", Materialized, QueryInstance().Select("id").From("tree")).
+			Select("id").
+			From("totals")
+	}
+
+	testCases := []struct {
+		query    *QueryBuilder
+		expected string
+	}{
+		{query(new(PostgreSQLDialect)), "WITH RECURSIVE tree AS (SELECT id FROM categories), totals AS MATERIALIZED (SELECT id FROM tree) SELECT id FROM totals"},
+		{query(new(SQLiteDialect)), "WITH RECURSIVE tree AS (SELECT id FROM categories), totals AS MATERIALIZED (SELECT id FROM tree) SELECT id FROM totals"},
+		{query(new(MySQLDialect)), "WITH RECURSIVE tree AS (SELECT id FROM categories), totals AS (SELECT id FROM tree) SELECT id FROM totals"},
+		{query(new(SQLServerDialect)), "WITH tree AS (SELECT id FROM categories), totals AS (SELECT id FROM tree) SELECT id FROM totals"},
+		{query(new(OracleDialect)), "WITH tree AS (SELECT id FROM categories), totals AS (SELECT id FROM tree) SELECT id FROM totals"},
+	}
+
+	for _, testCase := range testCases {
+		sql, _, err := testCase.query.Sql()
+		if err != nil || sql != testCase.expected {
+			t.Fatalf(`Query %s != %s (%v)`, sql, testCase.expected, err)
+		}
+
+		if testCase.query.String() != testCase.expected {
+			t.Fatalf(`Query %s != %s`, testCase.query.String(), testCase.expected)
+		}
+	}
+
+	// The body of a CTE must be a builder
+	if _, _, err := QueryInstance().With("totals", "SELECT 1").From("totals").Sql(); err == nil {
+		t.Fatalf("Expected an error for a CTE body which is not a builder")
+	}
+}